@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_delay_profile.example 10
\ No newline at end of file
+terraform import sonarr_delay_profile.example 10
+
+# import using the tag IDs in ascending order, the default profile has no tags
+terraform import sonarr_delay_profile.example tags:1,2
\ No newline at end of file
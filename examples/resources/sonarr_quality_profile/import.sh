@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_quality_profile.example 10
\ No newline at end of file
+terraform import sonarr_quality_profile.example 10
+
+# import using the name
+terraform import sonarr_quality_profile.example "name:Example"
\ No newline at end of file
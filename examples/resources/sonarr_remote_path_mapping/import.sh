@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_remote_path_mapping.example 10
\ No newline at end of file
+terraform import sonarr_remote_path_mapping.example 10
+
+# import using the host and remote path
+terraform import sonarr_remote_path_mapping.example "host:transmission|/downloads/"
\ No newline at end of file
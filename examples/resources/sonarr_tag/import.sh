@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_tag.example 10
\ No newline at end of file
+terraform import sonarr_tag.example 10
+
+# import using the label
+terraform import sonarr_tag.example label:example
\ No newline at end of file
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var errExport = errors.New("export failed")
+
+// export implements the `export` command, writing the Terraform configuration of a live Sonarr
+// along with the import blocks adopting its objects.
+func export(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	url := flags.String("url", os.Getenv("SONARR_URL"), "full Sonarr URL with protocol and port, defaults to SONARR_URL")
+	apiKey := flags.String("api-key", os.Getenv("SONARR_API_KEY"), "Sonarr API key, defaults to SONARR_API_KEY")
+	dir := flags.String("dir", ".", "directory where the .tf files are written")
+	types := flags.String("type", "", "comma separated resource types to export (e.g. sonarr_series,sonarr_tag)")
+	implementation := flags.String("implementation", "", "implementation of the exported objects (e.g. Transmission)")
+	tag := flags.String("tag", "", "label of a tag the exported objects must have")
+	name := flags.String("name", "", "regular expression matching the name of the exported objects")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	filter := provider.ExportFilter{Implementation: *implementation, Tag: *tag}
+	if *types != "" {
+		filter.Types = strings.Split(*types, ",")
+	}
+
+	if *name != "" {
+		pattern, err := regexp.Compile(*name)
+		if err != nil {
+			return err
+		}
+
+		filter.Name = pattern
+	}
+
+	config := sonarr.NewConfiguration()
+	config.AddDefaultHeader("X-Api-Key", *apiKey)
+	config.Servers = sonarr.ServerConfigurations{{URL: *url}}
+
+	files, diags := provider.Export(ctx, sonarr.NewAPIClient(config), filter)
+	for _, d := range diags {
+		fmt.Fprintf(os.Stderr, "%s: %s\n%s\n", severity(d), d.Summary(), d.Detail())
+	}
+
+	if diags.HasError() {
+		return errExport
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(*dir, name), files[name], 0o600); err != nil {
+			return err
+		}
+
+		fmt.Fprintln(os.Stdout, filepath.Join(*dir, name))
+	}
+
+	return nil
+}
+
+func severity(d diag.Diagnostic) string {
+	if d.Severity() == diag.SeverityError {
+		return "Error"
+	}
+
+	return "Warning"
+}
@@ -0,0 +1,215 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiPath is the path prefix of Sonarr API endpoints.
+const apiPath = "/api/v3/"
+
+// ListCache is an in-memory cache for Sonarr list endpoints (e.g. `GET /api/v3/series`), shared by a provider instance.
+// Concurrent identical calls are deduplicated and any write to an endpoint invalidates the cached lists of the same kind.
+type ListCache struct {
+	entries     map[string]*cacheEntry
+	calls       map[string]*cacheCall
+	generations map[string]uint64
+	ttl         time.Duration
+	mu          sync.Mutex
+}
+
+type cacheEntry struct {
+	expires time.Time
+	header  http.Header
+	kind    string
+	body    []byte
+	status  int
+}
+
+type cacheCall struct {
+	done  chan struct{}
+	entry *cacheEntry
+	err   error
+}
+
+// NewListCache creates a cache keeping list responses for the given TTL.
+func NewListCache(ttl time.Duration) *ListCache {
+	return &ListCache{
+		entries:     make(map[string]*cacheEntry),
+		calls:       make(map[string]*cacheCall),
+		generations: make(map[string]uint64),
+		ttl:         ttl,
+	}
+}
+
+// Invalidate removes the cached lists of the given kind (e.g. `series`).
+func (c *ListCache) Invalidate(kind string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[kind]++
+
+	for key, entry := range c.entries {
+		if entry.kind == kind {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Transport returns a http.RoundTripper serving list requests from the cache.
+func (c *ListCache) Transport(base http.RoundTripper) http.RoundTripper {
+	return &cacheTransport{
+		base:  base,
+		cache: c,
+	}
+}
+
+type cacheTransport struct {
+	base  http.RoundTripper
+	cache *ListCache
+}
+
+// RoundTrip serves list requests from the cache, invalidating it on writes.
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	kind, list := endpointKind(req.URL.Path)
+
+	switch {
+	case kind == "":
+		return base.RoundTrip(req)
+	case req.Method != http.MethodGet:
+		resp, err := base.RoundTrip(req)
+		t.cache.Invalidate(kind)
+
+		return resp, err
+	case !list:
+		return base.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+	c := t.cache
+
+	c.mu.Lock()
+
+	if entry, ok := c.entries[key]; ok && time.Now().Before(entry.expires) {
+		c.mu.Unlock()
+		tflog.Debug(req.Context(), "Sonarr list served from cache", map[string]interface{}{"url": req.URL.Redacted()})
+
+		return entry.response(req), nil
+	}
+
+	if call, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		// The shared call was cancelled by its own caller: repeat it with this request context.
+		if errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded) {
+			return t.RoundTrip(req)
+		}
+
+		if call.err != nil {
+			return nil, call.err
+		}
+
+		if call.entry == nil {
+			return base.RoundTrip(req)
+		}
+
+		return call.entry.response(req), nil
+	}
+
+	call := &cacheCall{done: make(chan struct{})}
+	c.calls[key] = call
+	generation := c.generations[kind]
+	c.mu.Unlock()
+
+	resp, err := base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusOK {
+		call.entry, err = newCacheEntry(resp, kind, c.ttl)
+	}
+
+	c.mu.Lock()
+	delete(c.calls, key)
+
+	// Results of calls overlapping a write are not cached.
+	if call.entry != nil && c.generations[kind] == generation {
+		c.entries[key] = call.entry
+	}
+	c.mu.Unlock()
+
+	call.err = err
+	close(call.done)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if call.entry != nil {
+		return call.entry.response(req), nil
+	}
+
+	return resp, nil
+}
+
+// newCacheEntry reads the response into a cache entry.
+func newCacheEntry(resp *http.Response, kind string, ttl time.Duration) (*cacheEntry, error) {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &cacheEntry{
+		expires: time.Now().Add(ttl),
+		header:  resp.Header.Clone(),
+		kind:    kind,
+		body:    body,
+		status:  resp.StatusCode,
+	}, nil
+}
+
+// response builds a new response from the cache entry.
+func (e *cacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.status, http.StatusText(e.status)),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// endpointKind returns the kind of API endpoint (e.g. `series` for `/api/v3/series/1`) and whether it is a list endpoint.
+func endpointKind(path string) (string, bool) {
+	index := strings.Index(path, apiPath)
+	if index < 0 {
+		return "", false
+	}
+
+	segments := strings.Split(strings.Trim(path[index+len(apiPath):], "/"), "/")
+
+	return strings.ToLower(segments[0]), len(segments) == 1 && segments[0] != ""
+}
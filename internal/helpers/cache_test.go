@@ -0,0 +1,172 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointKind(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path string
+		kind string
+		list bool
+	}{
+		"list":     {path: "/api/v3/series", kind: "series", list: true},
+		"url_base": {path: "/sonarr/api/v3/tag/", kind: "tag", list: true},
+		"item":     {path: "/api/v3/series/1", kind: "series", list: false},
+		"config":   {path: "/api/v3/config/host", kind: "config", list: false},
+		"other":    {path: "/ping", kind: "", list: false},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, list := endpointKind(test.path)
+			assert.Equal(t, test.kind, kind)
+			assert.Equal(t, test.list, list)
+		})
+	}
+}
+
+func TestListCache(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		_, _ = w.Write([]byte(`[{"id":1}]`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewListCache(time.Minute).Transport(nil)}
+	get := func(path string) {
+		resp, err := client.Get(server.URL + path)
+		assert.NoError(t, err)
+
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "200 OK", resp.Status)
+		assert.Equal(t, `[{"id":1}]`, string(body))
+	}
+
+	// Concurrent calls are deduplicated
+	var wg sync.WaitGroup
+
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			get("/api/v3/tag")
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Item endpoints and other kinds are not served from cache
+	get("/api/v3/tag/1")
+	get("/api/v3/series")
+	get("/api/v3/tag")
+	assert.Equal(t, int32(3), calls.Load())
+
+	// Writes invalidate the lists of the same kind
+	resp, err := client.Post(server.URL+"/api/v3/tag", "application/json", nil)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	get("/api/v3/tag")
+	get("/api/v3/series")
+	assert.Equal(t, int32(4), calls.Load())
+}
+
+func TestListCacheExpiration(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewListCache(time.Millisecond).Transport(nil)}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL + "/api/v3/tag")
+		assert.NoError(t, err)
+		resp.Body.Close()
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestListCacheCancelledCall(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-r.Context().Done()
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewListCache(time.Minute).Transport(nil)}
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+
+	go func() {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v3/tag", nil)
+		_, err := client.Do(req)
+		errs <- err
+	}()
+
+	<-started
+
+	// Wait on the in-flight call, then cancel it
+	result := make(chan *http.Response, 1)
+
+	go func() {
+		resp, err := client.Get(server.URL + "/api/v3/tag")
+		assert.NoError(t, err)
+		result <- resp
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+
+	// Requests waiting on a cancelled call are not cancelled
+	resp := <-result
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,101 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+)
+
+// ConcurrencyLimiter bounds the number of in-flight Sonarr requests, with a separate bound for writes.
+// A zero limit means unlimited.
+type ConcurrencyLimiter struct {
+	requests chan struct{}
+	writes   chan struct{}
+}
+
+// NewConcurrencyLimiter creates a limiter for the given maximum concurrent requests and writes.
+func NewConcurrencyLimiter(maxRequests, maxWrites int) *ConcurrencyLimiter {
+	limiter := &ConcurrencyLimiter{}
+
+	if maxRequests > 0 {
+		limiter.requests = make(chan struct{}, maxRequests)
+	}
+
+	if maxWrites > 0 {
+		limiter.writes = make(chan struct{}, maxWrites)
+	}
+
+	return limiter
+}
+
+// Acquire waits for a free slot, returning the function to release it.
+// Write requests take a write slot first, so that queued writes do not hold request slots.
+func (l *ConcurrencyLimiter) Acquire(ctx context.Context, write bool) (func(), error) {
+	var acquired []chan struct{}
+
+	release := func() {
+		for _, semaphore := range acquired {
+			<-semaphore
+		}
+	}
+
+	semaphores := []chan struct{}{l.requests}
+	if write {
+		semaphores = []chan struct{}{l.writes, l.requests}
+	}
+
+	for _, semaphore := range semaphores {
+		if semaphore == nil {
+			continue
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+			acquired = append(acquired, semaphore)
+		case <-ctx.Done():
+			release()
+
+			return nil, ctx.Err()
+		}
+	}
+
+	return release, nil
+}
+
+// Transport returns a http.RoundTripper throttled by the limiter.
+func (l *ConcurrencyLimiter) Transport(base http.RoundTripper) http.RoundTripper {
+	return &concurrencyTransport{
+		base:    base,
+		limiter: l,
+	}
+}
+
+type concurrencyTransport struct {
+	base    http.RoundTripper
+	limiter *ConcurrencyLimiter
+}
+
+// RoundTrip executes the request once a slot is available.
+func (t *concurrencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	release, err := t.limiter.Acquire(req.Context(), isWrite(req.Method))
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return base.RoundTrip(req)
+}
+
+// isWrite checks if the HTTP method modifies data.
+func isWrite(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,111 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrencyLimiter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method      string
+		maxRequests int
+		maxWrites   int
+		expected    int32
+	}{
+		"requests": {
+			method:      http.MethodGet,
+			maxRequests: 2,
+			maxWrites:   1,
+			expected:    2,
+		},
+		"writes": {
+			method:      http.MethodPost,
+			maxRequests: 2,
+			maxWrites:   1,
+			expected:    1,
+		},
+		"unlimited": {
+			method:   http.MethodPut,
+			expected: 5,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var current, peak atomic.Int32
+
+			ready := make(chan struct{})
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				value := current.Add(1)
+				defer current.Add(-1)
+
+				for {
+					old := peak.Load()
+					if value <= old || peak.CompareAndSwap(old, value) {
+						break
+					}
+				}
+
+				<-ready
+				time.Sleep(10 * time.Millisecond)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: NewConcurrencyLimiter(test.maxRequests, test.maxWrites).Transport(nil)}
+
+			var wg sync.WaitGroup
+
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					req, _ := http.NewRequest(test.method, server.URL, nil)
+					if resp, err := client.Do(req); err == nil {
+						resp.Body.Close()
+					}
+				}()
+			}
+
+			time.Sleep(50 * time.Millisecond)
+			close(ready)
+			wg.Wait()
+
+			assert.Equal(t, test.expected, peak.Load())
+		})
+	}
+}
+
+func TestConcurrencyLimiterCanceled(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewConcurrencyLimiter(1, 0)
+
+	release, err := limiter.Acquire(context.Background(), false)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = limiter.Acquire(ctx, true)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	release()
+
+	release, err = limiter.Acquire(context.Background(), true)
+	assert.NoError(t, err)
+	release()
+}
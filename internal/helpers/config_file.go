@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var ErrEmptyAPIKey = errors.New("API key not found")
+
+// SonarrConfigXML describes the relevant fields of Sonarr config.xml.
+type SonarrConfigXML struct {
+	XMLName     xml.Name `xml:"Config"`
+	APIKey      string   `xml:"ApiKey"`
+	BindAddress string   `xml:"BindAddress"`
+	URLBase     string   `xml:"UrlBase"`
+	EnableSsl   string   `xml:"EnableSsl"`
+	Port        int      `xml:"Port"`
+	SslPort     int      `xml:"SslPort"`
+}
+
+// ReadConfigXML parses Sonarr config.xml from the given path.
+func ReadConfigXML(path string) (*SonarrConfigXML, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &SonarrConfigXML{}
+	if err := xml.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", path, err)
+	}
+
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("%w in %s", ErrEmptyAPIKey, path)
+	}
+
+	return config, nil
+}
+
+// URL returns the local Sonarr URL according to bind address, port and SSL settings.
+func (c *SonarrConfigXML) URL() string {
+	// Wildcard bind addresses listen on localhost too.
+	host := "localhost"
+	if ip := net.ParseIP(c.BindAddress); c.BindAddress != "" && c.BindAddress != "*" && (ip == nil || !ip.IsUnspecified()) {
+		host = c.BindAddress
+	}
+
+	if ssl, _ := strconv.ParseBool(c.EnableSsl); ssl && c.SslPort != 0 {
+		return "https://" + net.JoinHostPort(host, strconv.Itoa(c.SslPort))
+	}
+
+	return "http://" + net.JoinHostPort(host, strconv.Itoa(c.Port))
+}
+
+// ReadSecretFile reads a secret from file, trimming surrounding whitespaces.
+func ReadSecretFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	secret := strings.TrimSpace(string(content))
+	if secret == "" {
+		return "", fmt.Errorf("%w in %s", ErrEmptyAPIKey, path)
+	}
+
+	return secret, nil
+}
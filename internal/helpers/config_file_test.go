@@ -0,0 +1,88 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadConfigXML(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content  string
+		expected string
+		urlBase  string
+		err      bool
+	}{
+		"http": {
+			content:  "<Config><BindAddress>*</BindAddress><Port>8989</Port><SslPort>9898</SslPort><EnableSsl>False</EnableSsl><ApiKey>key</ApiKey><UrlBase>/sonarr</UrlBase></Config>",
+			expected: "http://localhost:8989",
+			urlBase:  "/sonarr",
+		},
+		"https": {
+			content:  "<Config><BindAddress>127.0.0.1</BindAddress><Port>8989</Port><SslPort>9898</SslPort><EnableSsl>True</EnableSsl><ApiKey>key</ApiKey></Config>",
+			expected: "https://127.0.0.1:9898",
+		},
+		"ipv6": {
+			content:  "<Config><BindAddress>::1</BindAddress><Port>8989</Port><ApiKey>key</ApiKey></Config>",
+			expected: "http://[::1]:8989",
+		},
+		"ipv6_wildcard": {
+			content:  "<Config><BindAddress>::</BindAddress><Port>8989</Port><ApiKey>key</ApiKey></Config>",
+			expected: "http://localhost:8989",
+		},
+		"missing_key": {
+			content: "<Config><Port>8989</Port></Config>",
+			err:     true,
+		},
+		"invalid": {
+			content: "not xml",
+			err:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "config.xml")
+			assert.NoError(t, os.WriteFile(path, []byte(test.content), 0o600))
+
+			config, err := ReadConfigXML(path)
+			if test.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "key", config.APIKey)
+			assert.Equal(t, test.expected, config.URL())
+			assert.Equal(t, test.urlBase, config.URLBase)
+		})
+	}
+}
+
+func TestReadSecretFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	secret := filepath.Join(dir, "secret")
+	empty := filepath.Join(dir, "empty")
+	assert.NoError(t, os.WriteFile(secret, []byte("key\n"), 0o600))
+	assert.NoError(t, os.WriteFile(empty, []byte("\n"), 0o600))
+
+	key, err := ReadSecretFile(secret)
+	assert.NoError(t, err)
+	assert.Equal(t, "key", key)
+
+	_, err = ReadSecretFile(empty)
+	assert.ErrorIs(t, err, ErrEmptyAPIKey)
+
+	_, err = ReadSecretFile(filepath.Join(dir, "missing"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 )
@@ -19,12 +20,29 @@ const (
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
+	UnsupportedServerVersion          = "Unsupported Server Version"
+	ReadOnlyMode                      = "Read-Only Mode"
+	SecretDrift                       = "Secret Drift"
+	InvalidReference                  = "Invalid Reference"
+	UnexpectedMoveSource              = "Unexpected Move Source"
+	StateUpgradeError                 = "State Upgrade Error"
+	MissingAttribute                  = "Missing Attribute Configuration"
 )
 
 func ParseNotFoundError(kind, field, search string) string {
 	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
 }
 
+// ParseRemovedFromState returns the warning logged when a resource is no longer found remotely.
+func ParseRemovedFromState(name string, id int64) string {
+	return fmt.Sprintf("%s with ID %d not found, removing it from state", name, id)
+}
+
+// IsNotFound checks if the API response reports that the requested object does not exist.
+func IsNotFound(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusNotFound
+}
+
 func WrongClient(clientType string, providerData interface{}) string {
 	return fmt.Sprintf("Expected %s, got: %T. Please report this issue to the provider developers.", clientType, providerData)
 }
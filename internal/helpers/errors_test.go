@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -89,3 +90,39 @@ func TestWrongClient(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRemovedFromState(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "tag with ID 3 not found, removing it from state", ParseRemovedFromState("tag", 3))
+}
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resp     *http.Response
+		expected bool
+	}{
+		"not_found": {
+			resp:     &http.Response{StatusCode: http.StatusNotFound},
+			expected: true,
+		},
+		"unauthorized": {
+			resp:     &http.Response{StatusCode: http.StatusUnauthorized},
+			expected: false,
+		},
+		"nil": {
+			resp:     nil,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsNotFound(test.resp))
+		})
+	}
+}
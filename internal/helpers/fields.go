@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -256,6 +257,32 @@ func (f Fields) getList(list string) []string {
 	return output
 }
 
+// ClearedFields returns the API names of the fields set to null or to an empty set in the field container.
+// ReadFields skips them, so they must be reset explicitly when merged on the remote object.
+func ClearedFields(fieldContainer interface{}, fieldLists Fields) []string {
+	var output []string
+
+	for _, list := range []string{"Bools", "Ints", "Floats", "Strings", "StringSlices", "IntSlices"} {
+		for _, name := range fieldLists.getList(list) {
+			field := selectReadField(name, fieldContainer)
+			if !field.IsValid() {
+				continue
+			}
+
+			value, ok := field.Interface().(attr.Value)
+			if !ok || value.IsUnknown() {
+				continue
+			}
+
+			if set, isSet := value.(types.Set); value.IsNull() || (isSet && len(set.Elements()) == 0) {
+				output = append(output, selectAPIName(name))
+			}
+		}
+	}
+
+	return output
+}
+
 // ReadFields takes in input a field container and populates a sonarr.Field slice.
 func ReadFields(ctx context.Context, fieldContainer interface{}, fieldLists Fields) []sonarr.Field {
 	var output []sonarr.Field
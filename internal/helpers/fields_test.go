@@ -564,6 +564,27 @@ func TestReadFields(t *testing.T) {
 	}
 }
 
+func TestClearedFields(t *testing.T) {
+	t.Parallel()
+
+	testData := Test{
+		Str:      types.StringNull(),
+		In:       types.Int64Value(1),
+		SeedTime: types.Int64Null(),
+		Boo:      types.BoolUnknown(),
+		Set:      types.SetValueMust(types.StringType, nil),
+	}
+	fieldLists := Fields{
+		Strings:        []string{"str", "missing"},
+		Ints:           []string{"in", "seedTime"},
+		Bools:          []string{"boo"},
+		StringSlices:   []string{"set"},
+		IntsExceptions: []string{"fl"},
+	}
+
+	assert.ElementsMatch(t, []string{"str", "seedCriteria.seedTime", "set"}, ClearedFields(&testData, fieldLists))
+}
+
 func TestWriteFields(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,219 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// HTTPLogSubsystem is the tflog subsystem used to trace Sonarr requests.
+const HTTPLogSubsystem = "http"
+
+// providerLogEnv is the environment variable setting the provider log level, suffixed by the subsystem for its own level.
+const providerLogEnv = "TF_LOG_PROVIDER_SONARR"
+
+// SensitiveFields lists the API names of fields containing secrets, either as provider fields or as object properties.
+// Sonarr masks the provider fields in its responses.
+var SensitiveFields = []string{
+	"accessToken",
+	"accessTokenSecret",
+	"apiKey",
+	"appToken",
+	"authPassword",
+	"authToken",
+	"botToken",
+	"captchaToken",
+	"configurationKey",
+	"consumerKey",
+	"consumerSecret",
+	"cookie",
+	"key",
+	"passkey",
+	"password",
+	"passwordConfirmation",
+	"proxyPassword",
+	"refreshToken",
+	"secretToken",
+	"senderNumber",
+	"sslCertPassword",
+	"token",
+	"userKey",
+}
+
+// LoggingTransport is a http.RoundTripper tracing requests and responses through tflog.
+// Sensitive fields in JSON bodies and the given secrets are masked.
+type LoggingTransport struct {
+	Base    http.RoundTripper
+	Secrets []string
+}
+
+// RoundTrip executes the request, logging method, URL, status, latency and bodies.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	ctx := t.logContext(req.Context())
+
+	// Bodies are only logged at trace level, skip reading and redacting them otherwise.
+	traceBodies := isTraceLogging()
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.Redacted(),
+	}
+
+	if traceBodies {
+		requestBody, err := readRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+
+		tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "sending Sonarr request", map[string]interface{}{
+			"method": req.Method,
+			"url":    req.URL.Redacted(),
+			"body":   RedactBody(requestBody),
+		})
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	fields["latency"] = time.Since(start).String()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, HTTPLogSubsystem, "Sonarr request failed", fields)
+
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.SubsystemDebug(ctx, HTTPLogSubsystem, "Sonarr request completed", fields)
+
+	if !traceBodies {
+		return resp, nil
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	if err != nil {
+		return resp, err
+	}
+
+	fields["body"] = RedactBody(responseBody)
+	tflog.SubsystemTrace(ctx, HTTPLogSubsystem, "received Sonarr response", fields)
+
+	return resp, nil
+}
+
+// logContext sets up the logging subsystem with the secret masking.
+func (t *LoggingTransport) logContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, HTTPLogSubsystem, tflog.WithLevelFromEnv(providerLogEnv, HTTPLogSubsystem))
+
+	var secrets []string
+
+	for _, s := range t.Secrets {
+		if s != "" {
+			secrets = append(secrets, s)
+		}
+	}
+
+	ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, HTTPLogSubsystem, secrets...)
+
+	return tflog.SubsystemMaskMessageStrings(ctx, HTTPLogSubsystem, secrets...)
+}
+
+// isTraceLogging checks if the HTTP subsystem logs at trace level, following the precedence of
+// the Terraform log environment variables. Terraform logs at trace level values other than the known levels (e.g. JSON).
+func isTraceLogging() bool {
+	for _, env := range []string{providerLogEnv + "_" + strings.ToUpper(HTTPLogSubsystem), providerLogEnv, "TF_LOG_PROVIDER", "TF_LOG"} {
+		if value := os.Getenv(env); value != "" {
+			level := hclog.LevelFromString(value)
+
+			return level == hclog.Trace || level == hclog.NoLevel
+		}
+	}
+
+	return false
+}
+
+// readRequestBody returns the request body, leaving it readable for the next transport.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+
+		return io.ReadAll(body)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, err
+}
+
+// RedactBody masks the sensitive fields of a JSON body. Non JSON bodies are returned as they are.
+func RedactBody(body []byte) string {
+	var content interface{}
+	if err := json.Unmarshal(body, &content); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(content))
+	if err != nil {
+		return string(body)
+	}
+
+	return string(redacted)
+}
+
+// redactValue recursively masks sensitive properties and the values of sensitive provider fields.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		name, _ := v["name"].(string)
+
+		for key, item := range v {
+			if isSensitiveField(key) || (key == "value" && isSensitiveField(name)) {
+				if item != nil && item != "" {
+					v[key] = SensitiveValue
+				}
+
+				continue
+			}
+
+			v[key] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+
+	return value
+}
+
+// isSensitiveField checks if the API field contains a secret.
+func isSensitiveField(name string) bool {
+	return slices.Contains(SensitiveFields, name)
+}
@@ -0,0 +1,104 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"fields": {
+			body:     `{"fields":[{"name":"host","value":"localhost"},{"name":"password","value":"secret"}],"name":"test"}`,
+			expected: `{"fields":[{"name":"host","value":"localhost"},{"name":"password","value":"********"}],"name":"test"}`,
+		},
+		"properties": {
+			body:     `[{"apiKey":"secret","proxyPassword":"","port":8989}]`,
+			expected: `[{"apiKey":"********","port":8989,"proxyPassword":""}]`,
+		},
+		"not_json": {
+			body:     "plain text",
+			expected: "plain text",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, RedactBody([]byte(test.body)))
+		})
+	}
+}
+
+func TestLoggingTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"password":"secret"}`, string(body))
+
+		_, _ = w.Write([]byte(`{"apiKey":"secret"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &LoggingTransport{Secrets: []string{"secret", ""}}}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"password":"secret"}`))
+	assert.NoError(t, err)
+
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.Equal(t, `{"apiKey":"secret"}`, string(body))
+}
+
+func TestIsTraceLogging(t *testing.T) {
+	tests := map[string]struct {
+		env      map[string]string
+		expected bool
+	}{
+		"unset": {
+			env:      map[string]string{},
+			expected: false,
+		},
+		"trace": {
+			env:      map[string]string{"TF_LOG": "TRACE"},
+			expected: true,
+		},
+		"json": {
+			env:      map[string]string{"TF_LOG": "JSON"},
+			expected: true,
+		},
+		"debug": {
+			env:      map[string]string{"TF_LOG": "DEBUG"},
+			expected: false,
+		},
+		"provider_override": {
+			env:      map[string]string{"TF_LOG": "TRACE", "TF_LOG_PROVIDER": "INFO"},
+			expected: false,
+		},
+		"subsystem_override": {
+			env:      map[string]string{"TF_LOG_PROVIDER": "INFO", "TF_LOG_PROVIDER_SONARR_HTTP": "TRACE"},
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{"TF_LOG", "TF_LOG_PROVIDER", "TF_LOG_PROVIDER_SONARR", "TF_LOG_PROVIDER_SONARR_HTTP"} {
+				t.Setenv(env, test.env[env])
+			}
+
+			assert.Equal(t, test.expected, isTraceLogging())
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+// fieldsProperty is the API property holding the provider fields of download clients, indexers, notifications and so on.
+const fieldsProperty = "fields"
+
+// MergeRemote overlays the properties set in the update request on top of the remote object,
+// so that properties not managed by the provider keep their current value.
+// Provider fields are merged by name, any other property set in the request replaces the remote one.
+// The cleared provider fields (see ClearedFields) missing from the request are reset instead of kept.
+func MergeRemote[T any](remote, request *T, cleared []string) (*T, error) {
+	remoteMap, err := toMap(remote)
+	if err != nil {
+		return nil, err
+	}
+
+	requestMap, err := toMap(request)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range requestMap {
+		if key == fieldsProperty {
+			value = mergeFields(remoteMap[key], value, cleared)
+		}
+
+		remoteMap[key] = value
+	}
+
+	body, err := json.Marshal(remoteMap)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := new(T)
+	if err := json.Unmarshal(body, merged); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+// toMap converts an API object into its JSON properties, keeping numbers as they are.
+func toMap(object interface{}) (map[string]interface{}, error) {
+	body, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// mergeFields overlays the requested provider fields on the remote ones, matching them by name.
+func mergeFields(remote, request interface{}, cleared []string) interface{} {
+	remoteFields, ok := remote.([]interface{})
+	if !ok {
+		return request
+	}
+
+	requestFields, ok := request.([]interface{})
+	if !ok {
+		return request
+	}
+
+	merged := make([]interface{}, 0, len(remoteFields)+len(requestFields))
+	requested := make(map[string]map[string]interface{}, len(requestFields))
+
+	for _, field := range requestFields {
+		if f, ok := field.(map[string]interface{}); ok {
+			if name, ok := f["name"].(string); ok {
+				requested[name] = f
+			}
+		}
+	}
+
+	for _, field := range remoteFields {
+		f, ok := field.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := f["name"].(string)
+		if override, ok := requested[name]; ok {
+			for key, value := range override {
+				f[key] = value
+			}
+
+			delete(requested, name)
+		} else if slices.Contains(cleared, name) {
+			// Empty lists rather than nulls for list fields
+			if _, ok := f["value"].([]interface{}); ok {
+				f["value"] = []interface{}{}
+			} else {
+				f["value"] = nil
+			}
+		}
+
+		merged = append(merged, f)
+	}
+
+	// Keep fields unknown to the server in request order
+	for _, field := range requestFields {
+		if f, ok := field.(map[string]interface{}); ok {
+			if name, _ := f["name"].(string); requested[name] != nil {
+				merged = append(merged, f)
+			}
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,113 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeRemote(t *testing.T) {
+	t.Parallel()
+
+	remote := sonarr.NewSeriesResource()
+	remote.SetId(1)
+	remote.SetTitle("Breaking Bad")
+	remote.SetMonitored(false)
+	remote.SetSeriesType(sonarr.SERIESTYPES_ANIME)
+	remote.SetMonitorNewItems(sonarr.NEWITEMMONITORTYPES_NONE)
+	remote.SetTags([]int32{1, 2})
+	remote.SetSeasons([]sonarr.SeasonResource{{SeasonNumber: sonarr.PtrInt32(1), Monitored: sonarr.PtrBool(true)}})
+
+	request := sonarr.NewSeriesResource()
+	request.SetId(1)
+	request.SetMonitored(true)
+	request.SetTags([]int32{})
+
+	merged, err := MergeRemote(remote, request, nil)
+	assert.NoError(t, err)
+
+	// Managed properties are replaced
+	assert.Equal(t, int32(1), merged.GetId())
+	assert.True(t, merged.GetMonitored())
+	assert.Equal(t, []int32{}, merged.GetTags())
+	// Unmanaged properties are kept
+	assert.Equal(t, "Breaking Bad", merged.GetTitle())
+	assert.Equal(t, sonarr.SERIESTYPES_ANIME, merged.GetSeriesType())
+	assert.Equal(t, sonarr.NEWITEMMONITORTYPES_NONE, merged.GetMonitorNewItems())
+	assert.Equal(t, remote.GetSeasons(), merged.GetSeasons())
+	// Inputs are not modified
+	assert.False(t, remote.GetMonitored())
+	assert.False(t, request.HasTitle())
+}
+
+func TestMergeRemoteFields(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, value interface{}) sonarr.Field {
+		f := sonarr.NewField()
+		f.SetName(name)
+		f.SetValue(value)
+
+		return *f
+	}
+
+	remote := sonarr.NewDownloadClientResource()
+	remote.SetName("Transmission")
+	remote.SetPriority(1)
+	remote.SetFields([]sonarr.Field{field("host", "old"), field("port", 9091), field("urlBase", "/transmission/")})
+
+	label := field("host", "old")
+	label.SetLabel("Host")
+	remote.Fields[0] = label
+
+	request := sonarr.NewDownloadClientResource()
+	request.SetName("Transmission")
+	request.SetFields([]sonarr.Field{field("host", "new"), field("useSsl", true)})
+
+	merged, err := MergeRemote(remote, request, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, int32(1), merged.GetPriority())
+
+	fields := merged.GetFields()
+	assert.Len(t, fields, 4)
+	assert.Equal(t, "host", fields[0].GetName())
+	assert.Equal(t, "new", fields[0].GetValue())
+	assert.Equal(t, "Host", fields[0].GetLabel())
+	assert.Equal(t, "port", fields[1].GetName())
+	assert.EqualValues(t, 9091, fields[1].GetValue())
+	assert.Equal(t, "/transmission/", fields[2].GetValue())
+	assert.Equal(t, "useSsl", fields[3].GetName())
+	assert.Equal(t, true, fields[3].GetValue())
+}
+
+func TestMergeRemoteClearedFields(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, value interface{}) sonarr.Field {
+		f := sonarr.NewField()
+		f.SetName(name)
+		f.SetValue(value)
+
+		return *f
+	}
+
+	remote := sonarr.NewDownloadClientResource()
+	remote.SetFields([]sonarr.Field{field("host", "old"), field("urlBase", "/transmission/"), field("tags", []string{"tv"}), field("tvCategory", "tv")})
+
+	request := sonarr.NewDownloadClientResource()
+	request.SetFields([]sonarr.Field{field("host", "new")})
+
+	merged, err := MergeRemote(remote, request, []string{"urlBase", "tags", "password"})
+	assert.NoError(t, err)
+
+	fields := merged.GetFields()
+	assert.Len(t, fields, 4)
+	assert.Equal(t, "new", fields[0].GetValue())
+	// Cleared fields are reset, lists to empty ones
+	assert.Nil(t, fields[1].GetValue())
+	assert.Equal(t, []interface{}{}, fields[2].GetValue())
+	// Unmanaged fields are kept
+	assert.Equal(t, "tv", fields[3].GetValue())
+}
@@ -0,0 +1,29 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is returned for write requests when the provider is in read-only mode.
+var ErrReadOnly = errors.New("provider is in read-only mode")
+
+// ReadOnlyTransport is a http.RoundTripper rejecting any request that could modify Sonarr.
+type ReadOnlyTransport struct {
+	Base http.RoundTripper
+}
+
+// RoundTrip executes read requests, failing for writes.
+func (t *ReadOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if isWrite(req.Method) {
+		return nil, fmt.Errorf("%w: %s %s not allowed", ErrReadOnly, req.Method, req.URL.Redacted())
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,35 @@
+package helpers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadOnlyTransport(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &ReadOnlyTransport{}}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+		req, _ := http.NewRequest(method, server.URL, nil)
+		_, err = client.Do(req)
+		assert.ErrorIs(t, err, ErrReadOnly)
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+}
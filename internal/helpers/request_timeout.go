@@ -0,0 +1,52 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// TimeoutTransport is a http.RoundTripper canceling every request not completed within Timeout,
+// including the read of the response body. A zero Timeout disables it.
+type TimeoutTransport struct {
+	Base    http.RoundTripper
+	Timeout time.Duration
+}
+
+// RoundTrip executes the request with its own deadline, released when the response body is closed.
+func (t *TimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if t.Timeout <= 0 {
+		return base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.Timeout)
+
+	resp, err := base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// cancelBody releases the request context once the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
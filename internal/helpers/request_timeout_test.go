@@ -0,0 +1,39 @@
+package helpers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &TimeoutTransport{Timeout: 50 * time.Millisecond}}
+
+	// The body can still be read after the round trip
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", string(body))
+
+	_, err = client.Get(server.URL + "/slow")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,162 @@
+package helpers
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// databaseLockedMessage is returned by Sonarr when its SQLite database is busy.
+const databaseLockedMessage = "database is locked"
+
+// RetryTransport is a http.RoundTripper retrying transient Sonarr failures with an exponential backoff.
+type RetryTransport struct {
+	Base       http.RoundTripper
+	MinWait    time.Duration
+	MaxWait    time.Duration
+	MaxRetries int
+}
+
+// RoundTrip executes the request, retrying it while the failure is transient and retries are left.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	// Buffer the body so that it can be replayed on every attempt.
+	var body []byte
+
+	if req.Body != nil && req.GetBody == nil {
+		var err error
+
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, body)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := base.RoundTrip(attemptReq)
+		if attempt >= t.MaxRetries || !isRetryable(req.Method, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.Redacted(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+		}
+
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			// Drain the body to reuse the connection.
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		tflog.Warn(req.Context(), "retrying Sonarr request after transient failure", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff calculates the wait before the next attempt, honouring the Retry-After header when present.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, t.MaxWait)
+		}
+	}
+
+	wait := t.MinWait << attempt
+	if wait <= 0 || wait > t.MaxWait {
+		wait = t.MaxWait
+	}
+
+	return wait
+}
+
+// rewindRequest clones the request resetting its body.
+func rewindRequest(req *http.Request, body []byte) (*http.Request, error) {
+	clone := req.Clone(req.Context())
+
+	switch {
+	case req.GetBody != nil:
+		reader, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		clone.Body = reader
+	case body != nil:
+		clone.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	return clone, nil
+}
+
+// isRetryable identifies transient failures and failures of idempotent requests that can be safely retried.
+func isRetryable(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		return isIdempotent(method)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	// The request could have reached Sonarr before the gateway failed.
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotent(method)
+	case http.StatusInternalServerError:
+		return isDatabaseLocked(resp)
+	default:
+		return false
+	}
+}
+
+// isIdempotent checks if a request with the given method can be repeated without side effects.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDatabaseLocked checks if the response reports a locked SQLite database, preserving the body for later reads.
+func isDatabaseLocked(resp *http.Response) bool {
+	if resp.Body == nil {
+		return false
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return err == nil && strings.Contains(strings.ToLower(string(body)), databaseLockedMessage)
+}
@@ -0,0 +1,145 @@
+package helpers
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		body     string
+		status   int
+		expected int32
+	}{
+		"service_unavailable": {
+			method:   http.MethodPost,
+			status:   http.StatusServiceUnavailable,
+			expected: 3,
+		},
+		"database_locked": {
+			method:   http.MethodPost,
+			status:   http.StatusInternalServerError,
+			body:     "SQLite error (5): database is locked",
+			expected: 3,
+		},
+		"gateway_timeout_get": {
+			method:   http.MethodGet,
+			status:   http.StatusGatewayTimeout,
+			expected: 3,
+		},
+		"gateway_timeout_post": {
+			method:   http.MethodPost,
+			status:   http.StatusGatewayTimeout,
+			expected: 1,
+		},
+		"internal_error": {
+			method:   http.MethodGet,
+			status:   http.StatusInternalServerError,
+			body:     "generic error",
+			expected: 1,
+		},
+		"bad_request": {
+			method:   http.MethodPut,
+			status:   http.StatusBadRequest,
+			expected: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "payload", string(body))
+
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &RetryTransport{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond}}
+
+			req, _ := http.NewRequest(test.method, server.URL, strings.NewReader("payload"))
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Equal(t, test.body, string(body))
+			assert.Equal(t, test.expected, calls.Load())
+		})
+	}
+}
+
+func TestRetryTransportRecovers(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}}
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err      error
+		method   string
+		expected bool
+	}{
+		"get_connection_error": {
+			method:   http.MethodGet,
+			err:      io.ErrUnexpectedEOF,
+			expected: true,
+		},
+		"post_connection_error": {
+			method:   http.MethodPost,
+			err:      io.ErrUnexpectedEOF,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, isRetryable(test.method, nil, test.err))
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package helpers
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+)
+
+// secretSaltLength is the length in bytes of the random salt used to hash secrets.
+const secretSaltLength = 16
+
+// SecretHashes holds the salted hashes of the sensitive field values last sent to Sonarr, indexed by field name.
+// It is stored in the resource private state, so that secrets can be compared without keeping them in clear text.
+type SecretHashes struct {
+	Hashes map[string]string `json:"hashes"`
+	Salt   string            `json:"salt"`
+}
+
+// NewSecretHashes hashes the requested values of the fields masked by Sonarr in its response.
+func NewSecretHashes(request, response []sonarr.Field) (*SecretHashes, error) {
+	salt := make([]byte, secretSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	secrets := &SecretHashes{
+		Hashes: make(map[string]string),
+		Salt:   hex.EncodeToString(salt),
+	}
+	masked := MaskedFields(response)
+
+	for _, f := range request {
+		if value, ok := secretValue(f); ok && masked[f.GetName()] {
+			secrets.Hashes[f.GetName()] = secrets.hash(f.GetName(), value)
+		}
+	}
+
+	return secrets, nil
+}
+
+// Has checks whether a hash is stored for the given field.
+// No hash is stored for imported resources and for state created before the hashes were introduced.
+func (s *SecretHashes) Has(name string) bool {
+	_, ok := s.Hashes[name]
+
+	return ok
+}
+
+// Matches checks whether the value is the one last sent to Sonarr for the given field.
+func (s *SecretHashes) Matches(name, value string) bool {
+	hash, ok := s.Hashes[name]
+
+	return ok && hash == s.hash(name, value)
+}
+
+func (s *SecretHashes) hash(name, value string) string {
+	sum := sha256.Sum256([]byte(s.Salt + "\x00" + name + "\x00" + value))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// MaskedFields returns the names of the fields whose value is masked by Sonarr.
+func MaskedFields(fields []sonarr.Field) map[string]bool {
+	masked := make(map[string]bool)
+
+	for _, f := range fields {
+		if f.GetValue() == SensitiveValue {
+			masked[f.GetName()] = true
+		}
+	}
+
+	return masked
+}
+
+// SecretValues returns the values of the given fields that can hold a secret, indexed by field name.
+// Empty and masked values are skipped since they do not carry any secret.
+func SecretValues(fields []sonarr.Field) map[string]string {
+	values := make(map[string]string)
+
+	for _, f := range fields {
+		if value, ok := secretValue(f); ok {
+			values[f.GetName()] = value
+		}
+	}
+
+	return values
+}
+
+func secretValue(field sonarr.Field) (string, bool) {
+	if field.GetValue() == nil {
+		return "", false
+	}
+
+	value := fmt.Sprint(field.GetValue())
+
+	return value, value != "" && value != SensitiveValue
+}
@@ -0,0 +1,56 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretHashes(t *testing.T) {
+	t.Parallel()
+
+	request := []sonarr.Field{
+		setField("host", "localhost"),
+		setField("password", "secret"),
+		setField("apiKey", SensitiveValue),
+	}
+	response := []sonarr.Field{
+		setField("host", "localhost"),
+		setField("password", SensitiveValue),
+		setField("apiKey", SensitiveValue),
+	}
+
+	secrets, err := NewSecretHashes(request, response)
+	assert.NoError(t, err)
+
+	// Only secrets actually sent are hashed, never in clear text
+	assert.Len(t, secrets.Hashes, 1)
+	assert.NotContains(t, secrets.Hashes["password"], "secret")
+	assert.True(t, secrets.Matches("password", "secret"))
+	assert.False(t, secrets.Matches("password", "other"))
+	assert.False(t, secrets.Matches("apiKey", SensitiveValue))
+	assert.False(t, secrets.Matches("host", "localhost"))
+	assert.True(t, secrets.Has("password"))
+	assert.False(t, secrets.Has("host"))
+
+	// Salt differs across calls
+	other, err := NewSecretHashes(request, response)
+	assert.NoError(t, err)
+	assert.NotEqual(t, secrets.Hashes["password"], other.Hashes["password"])
+}
+
+func TestSecretValues(t *testing.T) {
+	t.Parallel()
+
+	fields := []sonarr.Field{
+		setField("host", "localhost"),
+		setField("password", "secret"),
+		setField("apiKey", SensitiveValue),
+		setField("username", ""),
+		*sonarr.NewField(),
+	}
+
+	assert.Equal(t, map[string]bool{"apiKey": true}, MaskedFields(fields))
+	assert.Equal(t, map[string]string{"host": "localhost", "password": "secret"}, SecretValues(fields))
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const pemPrefix = "-----BEGIN"
+
+var (
+	ErrInvalidCACertificate  = errors.New("no valid PEM certificate found in CA certificate")
+	ErrIncompleteCertificate = errors.New("client certificate and client key must be set together")
+)
+
+// readPEM returns the PEM content, reading it from file when the value is a path.
+func readPEM(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), pemPrefix) {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
+
+// TLSConfig builds the client TLS configuration. Certificates and keys can be either PEM contents or file paths.
+func TLSConfig(caCertificate, clientCertificate, clientKey string, insecureSkipVerify bool) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: insecureSkipVerify,
+	}
+
+	if caCertificate != "" {
+		ca, err := readPEM(caCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, ErrInvalidCACertificate
+		}
+
+		config.RootCAs = pool
+	}
+
+	if (clientCertificate == "") != (clientKey == "") {
+		return nil, ErrIncompleteCertificate
+	}
+
+	if clientCertificate != "" {
+		cert, err := readPEM(clientCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client certificate: %w", err)
+		}
+
+		key, err := readPEM(clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read client key: %w", err)
+		}
+
+		pair, err := tls.X509KeyPair(cert, key)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{pair}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,107 @@
+package helpers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCertificate generates a self-signed certificate and its key in PEM format.
+func testCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sonarr"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	cert, key := testCertificate(t)
+	certFile := filepath.Join(t.TempDir(), "cert.pem")
+	assert.NoError(t, os.WriteFile(certFile, []byte(cert), 0o600))
+
+	tests := map[string]struct {
+		expected error
+		ca       string
+		cert     string
+		key      string
+		insecure bool
+	}{
+		"empty": {},
+		"insecure": {
+			insecure: true,
+		},
+		"pem": {
+			ca:   cert,
+			cert: cert,
+			key:  key,
+		},
+		"file": {
+			ca:   certFile,
+			cert: certFile,
+			key:  key,
+		},
+		"invalid_ca": {
+			ca:       "-----BEGIN CERTIFICATE-----\ninvalid\n-----END CERTIFICATE-----",
+			expected: ErrInvalidCACertificate,
+		},
+		"missing_key": {
+			cert:     cert,
+			expected: ErrIncompleteCertificate,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := TLSConfig(test.ca, test.cert, test.key, test.insecure)
+			if test.expected != nil {
+				assert.ErrorIs(t, err, test.expected)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.insecure, config.InsecureSkipVerify)
+			assert.Equal(t, test.ca != "", config.RootCAs != nil)
+			assert.Equal(t, test.cert != "", len(config.Certificates) == 1)
+		})
+	}
+}
+
+func TestTLSConfigMissingFile(t *testing.T) {
+	t.Parallel()
+
+	_, err := TLSConfig(filepath.Join(t.TempDir(), "missing.pem"), "", "", false)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
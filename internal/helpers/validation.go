@@ -0,0 +1,137 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// ValidationFailure is a single failure reported by Sonarr when it rejects a payload.
+type ValidationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+	Severity     string `json:"severity"`
+}
+
+// PathMatcher is implemented by plan, state and config data, to check whether an attribute is part of their schema.
+type PathMatcher interface {
+	PathMatches(ctx context.Context, expression path.Expression) (path.Paths, diag.Diagnostics)
+}
+
+// ParseValidationFailures returns the validation failures in a Sonarr error response, if any.
+func ParseValidationFailures(err error) []ValidationFailure {
+	var (
+		apiError *sonarr.GenericOpenAPIError
+		failures []ValidationFailure
+	)
+
+	if !errors.As(err, &apiError) || json.Unmarshal(apiError.Body(), &failures) != nil {
+		return nil
+	}
+
+	return slices.DeleteFunc(failures, func(f ValidationFailure) bool { return f.ErrorMessage == "" })
+}
+
+// AddClientError adds the error returned by Sonarr to the diagnostics.
+// Validation failures are reported on the attribute matching their property name when it is part of the data schema,
+// and failures with warning severity are reported as warnings.
+func AddClientError(ctx context.Context, diags *diag.Diagnostics, data PathMatcher, fieldLists Fields, action, name string, err error) {
+	failed := false
+
+	for _, f := range ParseValidationFailures(err) {
+		warning := strings.EqualFold(f.Severity, "warning") || strings.EqualFold(f.Severity, "info")
+		detail := fmt.Sprintf("Unable to %s %s, got validation %s on %s: %s", action, name, strings.ToLower(f.Severity), f.PropertyName, f.ErrorMessage)
+		attribute, ok := validationPath(ctx, data, fieldLists, f.PropertyName)
+
+		switch {
+		case warning && ok:
+			diags.AddAttributeWarning(attribute, ClientError, detail)
+		case warning:
+			diags.AddWarning(ClientError, detail)
+		case ok:
+			diags.AddAttributeError(attribute, ClientError, detail)
+		default:
+			diags.AddError(ClientError, detail)
+		}
+
+		failed = failed || !warning
+	}
+
+	// The request was rejected even if only warnings were reported.
+	if !failed {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+	}
+}
+
+// validationPath returns the attribute path matching a Sonarr property name (e.g. `ApiKey` or `SeedCriteria.SeedTime`)
+// and whether it is part of the data schema.
+func validationPath(ctx context.Context, data PathMatcher, fieldLists Fields, property string) (path.Path, bool) {
+	segments := strings.Split(property, ".")
+	for i, s := range segments {
+		segments[i] = lowerFirst(s)
+	}
+
+	// Nested properties (e.g. `Items[0].Quality`) are reported on the root attribute.
+	name, _, _ := strings.Cut(segments[0], "[")
+	if apiName := strings.Join(segments, "."); fieldLists.isException(apiName) {
+		name = selectTFName(apiName)
+	}
+
+	if name == "" {
+		return path.Empty(), false
+	}
+
+	name = toSnakeCase(name)
+	matches, diags := data.PathMatches(ctx, path.MatchRoot(name))
+
+	return path.Root(name), !diags.HasError() && len(matches) != 0
+}
+
+// isException checks whether the API field name is part of the exception lists.
+func (f Fields) isException(name string) bool {
+	for _, list := range [][]string{f.BoolsExceptions, f.IntsExceptions, f.StringsExceptions, f.FloatsExceptions, f.IntSlicesExceptions, f.StringSlicesExceptions} {
+		if slices.Contains(list, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+
+	return string(r)
+}
+
+// toSnakeCase converts a camel case API name (e.g. `apiKey`) to the Terraform one (e.g. `api_key`).
+func toSnakeCase(s string) string {
+	var b strings.Builder
+
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,126 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// validationError returns the error of a request rejected by Sonarr with the given body.
+func validationError(t *testing.T, body string) error {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	config := sonarr.NewConfiguration()
+	config.Servers = sonarr.ServerConfigurations{{URL: server.URL}}
+	_, _, err := sonarr.NewAPIClient(config).TagAPI.CreateTag(context.Background()).TagResource(*sonarr.NewTagResource()).Execute()
+
+	return err
+}
+
+func TestAddClientError(t *testing.T) {
+	t.Parallel()
+
+	plan := tfsdk.Plan{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"name":       schema.StringAttribute{Required: true},
+				"api_key":    schema.StringAttribute{Optional: true},
+				"field_tags": schema.SetAttribute{Optional: true, ElementType: types.StringType},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"name":       tftypes.String,
+			"api_key":    tftypes.String,
+			"field_tags": tftypes.Set{ElementType: tftypes.String},
+		}}, map[string]tftypes.Value{
+			"name":       tftypes.NewValue(tftypes.String, "test"),
+			"api_key":    tftypes.NewValue(tftypes.String, nil),
+			"field_tags": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		}),
+	}
+	fieldLists := Fields{
+		Strings:                []string{"apiKey"},
+		StringSlicesExceptions: []string{"tags"},
+	}
+
+	tests := map[string]struct {
+		err      error
+		expected diag.Diagnostics
+	}{
+		"attributes": {
+			err: validationError(t, `[
+				{"propertyName":"ApiKey","errorMessage":"Invalid API key","severity":"error"},
+				{"propertyName":"Tags","errorMessage":"Unknown tag","severity":"warning"},
+				{"propertyName":"Host","errorMessage":"Unable to connect","severity":"error"}
+			]`),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("api_key"), ClientError, "Unable to create tag, got validation error on ApiKey: Invalid API key"),
+				diag.NewAttributeWarningDiagnostic(path.Root("field_tags"), ClientError, "Unable to create tag, got validation warning on Tags: Unknown tag"),
+				diag.NewErrorDiagnostic(ClientError, "Unable to create tag, got validation error on Host: Unable to connect"),
+			},
+		},
+		"warnings_only": {
+			err: validationError(t, `[{"propertyName":"Name","errorMessage":"Name already used","severity":"warning"}]`),
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("name"), ClientError, "Unable to create tag, got validation warning on Name: Name already used"),
+				diag.NewErrorDiagnostic(ClientError, "Unable to create tag, got error: 400 Bad Request\nDetails:\n"+
+					`[{"propertyName":"Name","errorMessage":"Name already used","severity":"warning"}]`),
+			},
+		},
+		"not_validation": {
+			err: errors.New("other error"),
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(ClientError, "Unable to create tag, got error: other error"),
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			AddClientError(context.Background(), &diags, plan, fieldLists, Create, "tag", test.err)
+			assert.Equal(t, test.expected, diags)
+		})
+	}
+}
+
+func TestToSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"apiKey":           "api_key",
+		"qualityProfileId": "quality_profile_id",
+		"name":             "name",
+		"fieldTags":        "field_tags",
+	}
+	for input, expected := range tests {
+		input, expected := input, expected
+
+		t.Run(input, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, expected, toSnakeCase(input))
+		})
+	}
+}
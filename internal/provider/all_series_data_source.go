@@ -111,7 +111,7 @@ func (d *AllSeriessDataSource) Configure(ctx context.Context, req datasource.Con
 
 func (d *AllSeriessDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get series current value
-	response, _, err := d.client.SeriesAPI.ListSeries(d.auth).Execute()
+	response, _, err := d.client.SeriesAPI.ListSeries(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, allSeriesDataSourceName, err))
 
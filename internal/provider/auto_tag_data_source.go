@@ -102,7 +102,7 @@ func (d *AutoTagDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	// Get autoTag current value
-	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(d.auth).Execute()
+	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagDataSourceName, err))
 
@@ -7,6 +7,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,6 +26,7 @@ const autoTagResourceName = "auto_tag"
 var (
 	_ resource.Resource                = &AutoTagResource{}
 	_ resource.ResourceWithImportState = &AutoTagResource{}
+	_ resource.ResourceWithModifyPlan  = &AutoTagResource{}
 )
 
 func NewAutoTagResource() resource.Resource {
@@ -32,8 +35,11 @@ func NewAutoTagResource() resource.Resource {
 
 // AutoTagResource defines the tag implementation.
 type AutoTagResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	version     *version.Version
+	defaultTags []int64
+	readOnly    bool
 }
 
 // AutoTag describes the tag data model.
@@ -45,6 +51,12 @@ type AutoTag struct {
 	RemoveTagsAutomatically types.Bool   `tfsdk:"remove_tags_automatically"`
 }
 
+// AutoTagResourceModel describes the auto tag resource data model, including timeouts.
+type AutoTagResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	AutoTag
+}
+
 func (t AutoTag) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -60,7 +72,7 @@ func (r *AutoTagResource) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_" + autoTagResourceName
 }
 
-func (r *AutoTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *AutoTagResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Tags -->\nAuto Tag resource.\nFor more information refer to [Tags](https://wiki.servarr.com/sonarr/settings#tags) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -94,6 +106,14 @@ func (r *AutoTagResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -134,12 +154,22 @@ func (r *AutoTagResource) Configure(ctx context.Context, req resource.ConfigureR
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.version = resourceServerVersion(req)
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *AutoTagResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkServerVersion(ctx, r.version, req.Config, autoTagResourceName, autoTagVersionRequirements)...)
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, autoTagResourceName, req.State, resp.Plan)...)
+}
+
 func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var autoTag *AutoTag
+	var autoTag *AutoTagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &autoTag)...)
 
@@ -147,12 +177,17 @@ func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, autoTag.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new auto tag
 	request := autoTag.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.AutoTaggingAPI.CreateAutoTagging(r.auth).AutoTaggingResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.AutoTaggingAPI.CreateAutoTagging(ctx).AutoTaggingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, autoTagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, autoTagResourceName, err)
 
 		return
 	}
@@ -161,11 +196,12 @@ func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest
 	// Generate resource state struct
 	autoTag.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &autoTag)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *AutoTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var autoTag *AutoTag
+	var autoTag *AutoTagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &autoTag)...)
 
@@ -173,9 +209,19 @@ func (r *AutoTagResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, autoTag.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get auto tag current value
-	response, _, err := r.client.AutoTaggingAPI.GetAutoTaggingById(r.auth, int32(autoTag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.AutoTaggingAPI.GetAutoTaggingById(ctx, int32(autoTag.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(autoTagResourceName, autoTag.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagResourceName, err))
 
 		return
@@ -185,11 +231,12 @@ func (r *AutoTagResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Map response body to resource schema attribute
 	autoTag.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &autoTag)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *AutoTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var autoTag *AutoTag
+	var autoTag *AutoTagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &autoTag)...)
 
@@ -197,12 +244,24 @@ func (r *AutoTagResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, autoTag.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update auto tag
 	request := autoTag.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.AutoTaggingAPI.UpdateAutoTagging(r.auth, fmt.Sprint(request.GetId())).AutoTaggingResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.AutoTaggingAPI.GetAutoTaggingById(ctx, int32(autoTag.ID.ValueInt64())).Execute, nil, autoTagResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.AutoTaggingAPI.UpdateAutoTagging(ctx, fmt.Sprint(request.GetId())).AutoTaggingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, autoTagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, autoTagResourceName, err)
 
 		return
 	}
@@ -211,19 +270,27 @@ func (r *AutoTagResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Generate resource state struct
 	autoTag.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &autoTag)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *AutoTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete auto tag current value
-	_, err := r.client.AutoTaggingAPI.DeleteAutoTagging(r.auth, int32(ID)).Execute()
+	_, err := r.client.AutoTaggingAPI.DeleteAutoTagging(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, autoTagResourceName, err))
 
@@ -235,7 +302,7 @@ func (r *AutoTagResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *AutoTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	autoTagImporter(r.client).importState(ctx, r.auth, autoTagResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+autoTagResourceName+": "+req.ID)
 }
 
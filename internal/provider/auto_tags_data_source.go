@@ -113,7 +113,7 @@ func (d *AutoTagsDataSource) Configure(ctx context.Context, req datasource.Confi
 
 func (d *AutoTagsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get download clients current value
-	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(d.auth).Execute()
+	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagsDataSourceName, err))
 
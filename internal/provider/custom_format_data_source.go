@@ -104,7 +104,7 @@ func (d *CustomFormatDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get customFormat current value
-	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(d.auth).Execute()
+	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatDataSourceName, err))
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -22,6 +23,7 @@ const customFormatResourceName = "custom_format"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &CustomFormatResource{}
+	_ resource.ResourceWithModifyPlan  = &CustomFormatResource{}
 	_ resource.ResourceWithImportState = &CustomFormatResource{}
 )
 
@@ -31,8 +33,9 @@ func NewCustomFormatResource() resource.Resource {
 
 // CustomFormatResource defines the custom format implementation.
 type CustomFormatResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // CustomFormat describes the custom format data model.
@@ -43,6 +46,12 @@ type CustomFormat struct {
 	IncludeCustomFormatWhenRenaming types.Bool   `tfsdk:"include_custom_format_when_renaming"`
 }
 
+// CustomFormatResourceModel describes the custom format resource data model, including timeouts.
+type CustomFormatResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	CustomFormat
+}
+
 func (c CustomFormat) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -57,7 +66,7 @@ func (r *CustomFormatResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_" + customFormatResourceName
 }
 
-func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *CustomFormatResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nCustom Format resource.\nFor more information refer to [Custom Format](https://wiki.servarr.com/sonarr/settings#custom-formats).",
 		Attributes: map[string]schema.Attribute{
@@ -85,6 +94,14 @@ func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -135,12 +152,17 @@ func (r *CustomFormatResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *CustomFormatResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, customFormatResourceName, req.State, resp.Plan)...)
+}
+
 func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var client *CustomFormat
+	var client *CustomFormatResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -148,12 +170,15 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(r.auth).CustomFormatResource(*request).Execute()
+	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(ctx).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, customFormatResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, customFormatResourceName, err)
 
 		return
 	}
@@ -161,7 +186,7 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 	tflog.Trace(ctx, "created "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	state := CustomFormatResourceModel{Timeouts: client.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -169,7 +194,7 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 
 func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var client CustomFormat
+	var client CustomFormatResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -177,9 +202,19 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get CustomFormat current value
-	response, _, err := r.client.CustomFormatAPI.GetCustomFormatById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.CustomFormatAPI.GetCustomFormatById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(customFormatResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatResourceName, err))
 
 		return
@@ -188,7 +223,7 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	tflog.Trace(ctx, "read "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	state := CustomFormatResourceModel{Timeouts: client.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -196,7 +231,7 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 
 func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var client *CustomFormat
+	var client *CustomFormatResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -204,12 +239,22 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(r.auth, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.CustomFormatAPI.GetCustomFormatById(ctx, int32(client.ID.ValueInt64())).Execute, nil, customFormatResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(ctx, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, customFormatResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, customFormatResourceName, err)
 
 		return
 	}
@@ -217,23 +262,30 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 	tflog.Trace(ctx, "updated "+customFormatResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state CustomFormat
+	state := CustomFormatResourceModel{Timeouts: client.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete CustomFormat current value
-	_, err := r.client.CustomFormatAPI.DeleteCustomFormat(r.auth, int32(ID)).Execute()
+	_, err := r.client.CustomFormatAPI.DeleteCustomFormat(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, customFormatResourceName, err))
 
@@ -245,7 +297,7 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *CustomFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	customFormatImporter(r.client).importState(ctx, r.auth, customFormatResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+customFormatResourceName+": "+req.ID)
 }
 
@@ -116,7 +116,7 @@ func (d *CustomFormatsDataSource) Configure(ctx context.Context, req datasource.
 
 func (d *CustomFormatsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get download clients current value
-	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(d.auth).Execute()
+	response, _, err := d.client.CustomFormatAPI.ListCustomFormat(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatsDataSourceName, err))
 
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resolveDefaultTags converts the provider default tags, expressed as labels or IDs, into tag IDs.
+func resolveDefaultTags(ctx context.Context, data *SonarrData, values []string) ([]int64, diag.Diagnostics) {
+	var (
+		diags  diag.Diagnostics
+		ids    []int64
+		labels []string
+	)
+
+	for _, v := range values {
+		if id, err := strconv.ParseInt(v, 10, 64); err == nil {
+			ids = append(ids, id)
+		} else {
+			labels = append(labels, strings.ToLower(v))
+		}
+	}
+
+	if len(labels) == 0 {
+		return ids, diags
+	}
+
+	tags, _, err := data.Client.TagAPI.ListTag(requestContext(ctx, data.Auth)).Execute()
+	if err != nil {
+		diags.AddAttributeError(path.Root("default_tags"), helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+		return nil, diags
+	}
+
+	for _, label := range labels {
+		index := slices.IndexFunc(tags, func(t sonarr.TagResource) bool { return t.GetLabel() == label })
+		if index < 0 {
+			diags.AddAttributeError(path.Root("default_tags"), "Default Tag Not Found", fmt.Sprintf("Tag '%s' does not exist in Sonarr, create it before using it as default tag.", label))
+
+			continue
+		}
+
+		ids = append(ids, int64(tags[index].GetId()))
+	}
+
+	return ids, diags
+}
+
+// attributeGetter is implemented by plan and state data.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// applyDefaultTags plans the prior tags of resources without tags configured, instead of an unknown value.
+// The provider default tags are added when sending the resource to Sonarr and are never stored in `tags`,
+// so the plan does not depend on them.
+func applyDefaultTags(ctx context.Context, defaultTags []int64, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy or without default tags.
+	if len(defaultTags) == 0 || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configTags, planTags types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("tags"), &planTags)...)
+
+	if resp.Diagnostics.HasError() || !configTags.IsNull() || !planTags.IsUnknown() {
+		return
+	}
+
+	tags := types.SetValueMust(types.Int64Type, nil)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags"), tags)...)
+}
+
+// requireTags reports a missing `tags` configuration when the provider has no default tags,
+// for resources that Sonarr rejects without tags.
+func requireTags(ctx context.Context, defaultTags []int64, resourceName string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if len(defaultTags) != 0 || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configTags types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &configTags)...)
+
+	if configTags.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("tags"), helpers.MissingAttribute, fmt.Sprintf("%s requires tags unless the provider default_tags are set.", resourceName))
+	}
+}
+
+// addDefaultTags returns the tags sent to Sonarr: the resource tags and the provider default tags.
+func addDefaultTags(defaultTags []int64, tags []int32) []int32 {
+	for _, tag := range defaultTags {
+		if !slices.Contains(tags, int32(tag)) {
+			tags = append(tags, int32(tag))
+		}
+	}
+
+	return tags
+}
+
+// removeDefaultTags removes from the new state the default tags missing from the prior tags,
+// i.e. the plan on apply and the state on refresh, so that the tags added by addDefaultTags never show as drift.
+func removeDefaultTags(ctx context.Context, defaultTags []int64, prior attributeGetter, state *tfsdk.State, diags *diag.Diagnostics) {
+	if len(defaultTags) == 0 || state.Raw.IsNull() {
+		return
+	}
+
+	var priorTags, stateTags types.Set
+
+	diags.Append(prior.GetAttribute(ctx, path.Root("tags"), &priorTags)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("tags"), &stateTags)...)
+
+	if diags.HasError() || stateTags.IsNull() || stateTags.IsUnknown() {
+		return
+	}
+
+	var kept []int64
+
+	tags := []int64{}
+	if !priorTags.IsNull() && !priorTags.IsUnknown() {
+		diags.Append(priorTags.ElementsAs(ctx, &kept, false)...)
+	}
+
+	diags.Append(stateTags.ElementsAs(ctx, &tags, false)...)
+
+	tags = slices.DeleteFunc(tags, func(tag int64) bool { return slices.Contains(defaultTags, tag) && !slices.Contains(kept, tag) })
+
+	value, tagDiags := types.SetValueFrom(ctx, types.Int64Type, tags)
+	diags.Append(tagDiags...)
+	diags.Append(state.SetAttribute(ctx, path.Root("tags"), value)...)
+}
+
+// resourceDefaultTags returns the provider default tags, if any.
+func resourceDefaultTags(req resource.ConfigureRequest) []int64 {
+	if providerData, ok := req.ProviderData.(*SonarrData); ok {
+		return providerData.DefaultTags
+	}
+
+	return nil
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.SetAttribute{Optional: true, Computed: true, ElementType: types.Int64Type},
+		},
+	}
+	setType := tftypes.Set{ElementType: tftypes.Number}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"tags": setType}}
+	tagsValue := func(value interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"tags": tftypes.NewValue(setType, value)})
+	}
+	numbers := func(values ...int64) []tftypes.Value {
+		output := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			output[i] = tftypes.NewValue(tftypes.Number, v)
+		}
+
+		return output
+	}
+
+	tests := map[string]struct {
+		config      tftypes.Value
+		plan        tftypes.Value
+		state       tftypes.Value
+		defaultTags []int64
+		expected    []int64
+		unknown     bool
+	}{
+		"no_default_tags": {
+			config:  tagsValue(nil),
+			plan:    tagsValue(tftypes.UnknownValue),
+			unknown: true,
+		},
+		"configured_tags": {
+			config:      tagsValue(numbers(1)),
+			plan:        tagsValue(numbers(1)),
+			defaultTags: []int64{1, 5},
+			expected:    []int64{1},
+		},
+		"unset_tags": {
+			config:      tagsValue(nil),
+			plan:        tagsValue(tftypes.UnknownValue),
+			defaultTags: []int64{5},
+			expected:    []int64{},
+		},
+		"unset_tags_with_state": {
+			config:      tagsValue(nil),
+			plan:        tagsValue(tftypes.UnknownValue),
+			state:       tagsValue(numbers(2)),
+			defaultTags: []int64{5},
+			expected:    []int64{2},
+		},
+		"unknown_tags": {
+			config:      tagsValue(tftypes.UnknownValue),
+			plan:        tagsValue(tftypes.UnknownValue),
+			defaultTags: []int64{5},
+			unknown:     true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			state := test.state
+			if state.Type() == nil {
+				state = tftypes.NewValue(objectType, nil)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: testSchema, Raw: test.config},
+				Plan:   tfsdk.Plan{Schema: testSchema, Raw: test.plan},
+				State:  tfsdk.State{Schema: testSchema, Raw: state},
+			}
+			resp := &resource.ModifyPlanResponse{
+				Plan: tfsdk.Plan{Schema: testSchema, Raw: test.plan},
+			}
+
+			applyDefaultTags(ctx, test.defaultTags, req, resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var tags types.Set
+
+			resp.Plan.GetAttribute(ctx, path.Root("tags"), &tags)
+
+			if test.unknown {
+				assert.True(t, tags.IsUnknown())
+
+				return
+			}
+
+			expected, _ := types.SetValueFrom(ctx, types.Int64Type, test.expected)
+			assert.True(t, expected.Equal(tags))
+		})
+	}
+}
+
+func TestAddDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, addDefaultTags(nil, nil))
+	assert.Equal(t, []int32{1, 5}, addDefaultTags([]int64{1, 5}, nil))
+	assert.Equal(t, []int32{2, 1, 5}, addDefaultTags([]int64{1, 5}, []int32{2, 1}))
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.SetAttribute{Optional: true, Computed: true, ElementType: types.Int64Type},
+		},
+	}
+	setType := tftypes.Set{ElementType: tftypes.Number}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"tags": setType}}
+	tagsValue := func(values ...int64) tftypes.Value {
+		tags := make([]tftypes.Value, len(values))
+		for i, v := range values {
+			tags[i] = tftypes.NewValue(tftypes.Number, v)
+		}
+
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"tags": tftypes.NewValue(setType, tags)})
+	}
+
+	tests := map[string]struct {
+		prior       tftypes.Value
+		state       tftypes.Value
+		defaultTags []int64
+		expected    []int64
+	}{
+		"no_default_tags": {
+			prior:    tagsValue(),
+			state:    tagsValue(1, 5),
+			expected: []int64{1, 5},
+		},
+		"default_tags": {
+			prior:       tagsValue(2),
+			state:       tagsValue(2, 5),
+			defaultTags: []int64{5},
+			expected:    []int64{2},
+		},
+		"only_default_tags": {
+			prior:       tagsValue(),
+			state:       tagsValue(5),
+			defaultTags: []int64{5},
+			expected:    []int64{},
+		},
+		"configured_default_tags": {
+			prior:       tagsValue(1, 2),
+			state:       tagsValue(1, 2, 5),
+			defaultTags: []int64{1, 5},
+			expected:    []int64{1, 2},
+		},
+		"imported": {
+			prior:       tftypes.NewValue(objectType, map[string]tftypes.Value{"tags": tftypes.NewValue(setType, nil)}),
+			state:       tagsValue(2, 5),
+			defaultTags: []int64{5},
+			expected:    []int64{2},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			state := tfsdk.State{Schema: testSchema, Raw: test.state}
+
+			var diags diag.Diagnostics
+
+			removeDefaultTags(ctx, test.defaultTags, tfsdk.Plan{Schema: testSchema, Raw: test.prior}, &state, &diags)
+			assert.False(t, diags.HasError())
+
+			var tags types.Set
+
+			state.GetAttribute(ctx, path.Root("tags"), &tags)
+
+			expected, _ := types.SetValueFrom(ctx, types.Int64Type, test.expected)
+			assert.True(t, expected.Equal(tags))
+		})
+	}
+}
+
+func TestRequireTags(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.SetAttribute{Optional: true, Computed: true, ElementType: types.Int64Type},
+		},
+	}
+	setType := tftypes.Set{ElementType: tftypes.Number}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"tags": setType}}
+	tagsValue := func(value interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"tags": tftypes.NewValue(setType, value)})
+	}
+
+	tests := map[string]struct {
+		config      tftypes.Value
+		defaultTags []int64
+		err         bool
+	}{
+		"unset_tags": {
+			config: tagsValue(nil),
+			err:    true,
+		},
+		"empty_tags": {
+			config: tagsValue([]tftypes.Value{}),
+		},
+		"default_tags": {
+			config:      tagsValue(nil),
+			defaultTags: []int64{5},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: testSchema, Raw: test.config},
+				Plan:   tfsdk.Plan{Schema: testSchema, Raw: test.config},
+			}
+			resp := &resource.ModifyPlanResponse{}
+
+			requireTags(context.Background(), test.defaultTags, delayProfileResourceName, req, resp)
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+		})
+	}
+}
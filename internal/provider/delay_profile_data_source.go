@@ -102,7 +102,7 @@ func (d *DelayProfileDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get delayprofiles current value
-	response, _, err := d.client.DelayProfileAPI.ListDelayProfile(d.auth).Execute()
+	response, _, err := d.client.DelayProfileAPI.ListDelayProfile(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileDataSourceName, err))
 
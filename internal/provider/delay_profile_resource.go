@@ -6,6 +6,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -25,6 +27,7 @@ const delayProfileResourceName = "delay_profile"
 var (
 	_ resource.Resource                = &DelayProfileResource{}
 	_ resource.ResourceWithImportState = &DelayProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &DelayProfileResource{}
 )
 
 func NewDelayProfileResource() resource.Resource {
@@ -33,8 +36,11 @@ func NewDelayProfileResource() resource.Resource {
 
 // DelayProfileResource defines the delay profile implementation.
 type DelayProfileResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	version     *version.Version
+	defaultTags []int64
+	readOnly    bool
 }
 
 // DelayProfile describes the delay profile data model.
@@ -52,6 +58,12 @@ type DelayProfile struct {
 	BypassIfAboveCustomFormatScore types.Bool   `tfsdk:"bypass_if_above_custom_format_score"`
 }
 
+// DelayProfileResourceModel describes the delay profile resource data model, including timeouts.
+type DelayProfileResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	DelayProfile
+}
+
 func (p DelayProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -73,7 +85,7 @@ func (r *DelayProfileResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_" + delayProfileResourceName
 }
 
-func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DelayProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nDelay Profile resource.\nFor more information refer to [Delay Profiles](https://wiki.servarr.com/sonarr/settings#delay-profiles) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -125,8 +137,9 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 			},
 			"tags": schema.SetAttribute{
-				MarkdownDescription: "List of associated tags.",
-				Required:            true,
+				MarkdownDescription: "List of associated tags. Required unless provider `default_tags` is set.",
+				Optional:            true,
+				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
 			"preferred_protocol": schema.StringAttribute{
@@ -138,6 +151,14 @@ func (r *DelayProfileResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -145,12 +166,23 @@ func (r *DelayProfileResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.version = resourceServerVersion(req)
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *DelayProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkServerVersion(ctx, r.version, req.Config, delayProfileResourceName, delayProfileVersionRequirements)...)
+	requireTags(ctx, r.defaultTags, delayProfileResourceName, req, resp)
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, delayProfileResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *DelayProfile
+	var profile *DelayProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -158,13 +190,18 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Create resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
 	// Create new DelayProfile
-	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(r.auth).DelayProfileResource(*request).Execute()
+	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(ctx).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, delayProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, delayProfileResourceName, err)
 
 		return
 	}
@@ -175,9 +212,9 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	if !profile.Order.IsUnknown() {
 		response.Order = request.Order
 
-		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
+		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(ctx, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+			helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, delayProfileResourceName, err)
 
 			return
 		}
@@ -186,11 +223,12 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *DelayProfile
+	var profile *DelayProfileResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -198,9 +236,19 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get delayprofile current value
-	response, _, err := r.client.DelayProfileAPI.GetDelayProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DelayProfileAPI.GetDelayProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(delayProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileResourceName, err))
 
 		return
@@ -210,11 +258,12 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 	// Map response body to resource schema attribute
 	profile.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *DelayProfile
+	var profile *DelayProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -222,13 +271,25 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DelayProfileAPI.GetDelayProfileById(ctx, int32(profile.ID.ValueInt64())).Execute, nil, delayProfileResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
 	// Update DelayProfile
-	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
+	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(ctx, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, delayProfileResourceName, err)
 
 		return
 	}
@@ -237,19 +298,27 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DelayProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete delayprofile current value
-	_, err := r.client.DelayProfileAPI.DeleteDelayProfile(r.auth, int32(ID)).Execute()
+	_, err := r.client.DelayProfileAPI.DeleteDelayProfile(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, delayProfileResourceName, err))
 
@@ -261,7 +330,7 @@ func (r *DelayProfileResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *DelayProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	delayProfileImporter(r.client).importState(ctx, r.auth, delayProfileResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+delayProfileResourceName+": "+req.ID)
 }
 
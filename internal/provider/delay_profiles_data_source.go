@@ -112,7 +112,7 @@ func (d *DelayProfilesDataSource) Configure(ctx context.Context, req datasource.
 
 func (d *DelayProfilesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get delayprofiles current value
-	response, _, err := d.client.DelayProfileAPI.ListDelayProfile(d.auth).Execute()
+	response, _, err := d.client.DelayProfileAPI.ListDelayProfile(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileResourceName, err))
 
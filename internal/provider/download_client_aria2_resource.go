@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientAria2Resource{}
 	_ resource.ResourceWithImportState = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientAria2Resource{}
 )
 
 func NewDownloadClientAria2Resource() resource.Resource {
@@ -35,24 +38,28 @@ func NewDownloadClientAria2Resource() resource.Resource {
 
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // DownloadClientAria2 describes the download client data model.
 type DownloadClientAria2 struct {
-	Tags                     types.Set    `tfsdk:"tags"`
-	Name                     types.String `tfsdk:"name"`
-	Host                     types.String `tfsdk:"host"`
-	RPCPath                  types.String `tfsdk:"rpc_path"`
-	SecretToken              types.String `tfsdk:"secret_token"`
-	Priority                 types.Int64  `tfsdk:"priority"`
-	Port                     types.Int64  `tfsdk:"port"`
-	ID                       types.Int64  `tfsdk:"id"`
-	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
-	Enable                   types.Bool   `tfsdk:"enable"`
-	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
-	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	Tags                     types.Set      `tfsdk:"tags"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Name                     types.String   `tfsdk:"name"`
+	Host                     types.String   `tfsdk:"host"`
+	RPCPath                  types.String   `tfsdk:"rpc_path"`
+	SecretToken              types.String   `tfsdk:"secret_token"`
+	Priority                 types.Int64    `tfsdk:"priority"`
+	Port                     types.Int64    `tfsdk:"port"`
+	ID                       types.Int64    `tfsdk:"id"`
+	UseSsl                   types.Bool     `tfsdk:"use_ssl"`
+	Enable                   types.Bool     `tfsdk:"enable"`
+	RemoveFailedDownloads    types.Bool     `tfsdk:"remove_failed_downloads"`
+	RemoveCompletedDownloads types.Bool     `tfsdk:"remove_completed_downloads"`
 }
 
 func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
@@ -94,7 +101,7 @@ func (r *DownloadClientAria2Resource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientAria2ResourceName
 }
 
-func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DownloadClientAria2Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Aria2 resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients) and [Aria2](https://wiki.servarr.com/sonarr/supported#aria2).",
 		Attributes: map[string]schema.Attribute{
@@ -163,6 +170,14 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 				Sensitive:           true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -170,9 +185,18 @@ func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *DownloadClientAria2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, downloadClientAria2ResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientAria2
@@ -183,20 +207,27 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Create, downloadClientAria2ResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -209,18 +240,33 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get DownloadClientAria2 current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(downloadClientAria2ResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, client.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, downloadClientSecretTest(ctx, r.client, *response), downloadClientAria2ResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -233,33 +279,54 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute, helpers.ClearedFields(client, downloadClientFields), downloadClientAria2ResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Update, downloadClientAria2ResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+downloadClientAria2ResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete DownloadClientAria2 current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientAria2ResourceName, err))
 
@@ -271,10 +338,19 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	downloadClientImporter(r.client, downloadClientAria2Implementation).importState(ctx, r.auth, downloadClientAria2ResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientAria2Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return downloadClientMover(ctx, downloadClientAria2Implementation, func(client *DownloadClientResourceModel) interface{} {
+		moved := DownloadClientAria2{Timeouts: client.Timeouts}
+		moved.fromDownloadClient(&client.DownloadClient)
+
+		return &moved
+	})
+}
+
 func (d *DownloadClientAria2) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +21,7 @@ const downloadClientConfigResourceName = "download_client_config"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &DownloadClientConfigResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientConfigResource{}
 	_ resource.ResourceWithImportState = &DownloadClientConfigResource{}
 )
 
@@ -29,8 +31,9 @@ func NewDownloadClientConfigResource() resource.Resource {
 
 // DownloadClientConfigResource defines the download client config implementation.
 type DownloadClientConfigResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // DownloadClientConfig describes the download client config data model.
@@ -41,11 +44,17 @@ type DownloadClientConfig struct {
 	AutoRedownloadFailed            types.Bool   `tfsdk:"auto_redownload_failed"`
 }
 
+// DownloadClientConfigResourceModel describes the download client config resource data model, including timeouts.
+type DownloadClientConfigResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	DownloadClientConfig
+}
+
 func (r *DownloadClientConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientConfigResourceName
 }
 
-func (r *DownloadClientConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DownloadClientConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Config resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#completed-download-handling) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -69,6 +78,13 @@ func (r *DownloadClientConfigResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -76,12 +92,17 @@ func (r *DownloadClientConfigResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *DownloadClientConfigResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, downloadClientConfigResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var config *DownloadClientConfig
+	var config *DownloadClientConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -89,14 +110,17 @@ func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, config.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Create resource
 	request := config.read()
 	request.SetId(1)
 
 	// Create new DownloadClientConfig
-	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, downloadClientConfigResourceName, err)
 
 		return
 	}
@@ -109,7 +133,7 @@ func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.
 
 func (r *DownloadClientConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var config *DownloadClientConfig
+	var config *DownloadClientConfigResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
 
@@ -117,8 +141,11 @@ func (r *DownloadClientConfigResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, config.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get downloadClientConfig current value
-	response, _, err := r.client.DownloadClientConfigAPI.GetDownloadClientConfig(r.auth).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.GetDownloadClientConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientConfigResourceName, err))
 
@@ -133,7 +160,7 @@ func (r *DownloadClientConfigResource) Read(ctx context.Context, req resource.Re
 
 func (r *DownloadClientConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var config *DownloadClientConfig
+	var config *DownloadClientConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -141,13 +168,23 @@ func (r *DownloadClientConfigResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, config.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
 	request := config.read()
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DownloadClientConfigAPI.GetDownloadClientConfig(ctx).Execute, nil, downloadClientConfigResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientConfig
-	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, downloadClientConfigResourceName, err)
 
 		return
 	}
@@ -123,11 +123,12 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 				Computed:            true,
 			},
 			"initial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
+				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause, `3` Stop (uTorrent only).",
 				Computed:            true,
 			},
 			"intial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
+				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop. Deprecated, use `initial_state` instead.",
+				DeprecationMessage:  intialStateDeprecation,
 				Computed:            true,
 			},
 			"host": schema.StringAttribute{
@@ -236,7 +237,7 @@ func (d *DownloadClientDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 	// Get downloadClient current value
-	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
+	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDataSourceName, err))
 
@@ -253,6 +254,7 @@ func (d *DownloadClient) find(ctx context.Context, name string, downloadClients
 	for _, client := range downloadClients {
 		if client.GetName() == name {
 			d.write(ctx, &client, diags)
+			d.writeIntialState()
 
 			return
 		}
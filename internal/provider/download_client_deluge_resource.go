@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +30,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientDelugeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientDelugeResource{}
 )
 
 func NewDownloadClientDelugeResource() resource.Resource {
@@ -37,29 +40,33 @@ func NewDownloadClientDelugeResource() resource.Resource {
 
 // DownloadClientDelugeResource defines the download client implementation.
 type DownloadClientDelugeResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // DownloadClientDeluge describes the download client data model.
 type DownloadClientDeluge struct {
-	Tags                     types.Set    `tfsdk:"tags"`
-	Name                     types.String `tfsdk:"name"`
-	Host                     types.String `tfsdk:"host"`
-	URLBase                  types.String `tfsdk:"url_base"`
-	Password                 types.String `tfsdk:"password"`
-	TvCategory               types.String `tfsdk:"tv_category"`
-	TvImportedCategory       types.String `tfsdk:"tv_imported_category"`
-	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
-	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
-	Priority                 types.Int64  `tfsdk:"priority"`
-	Port                     types.Int64  `tfsdk:"port"`
-	ID                       types.Int64  `tfsdk:"id"`
-	AddPaused                types.Bool   `tfsdk:"add_paused"`
-	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
-	Enable                   types.Bool   `tfsdk:"enable"`
-	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
-	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	Tags                     types.Set      `tfsdk:"tags"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Name                     types.String   `tfsdk:"name"`
+	Host                     types.String   `tfsdk:"host"`
+	URLBase                  types.String   `tfsdk:"url_base"`
+	Password                 types.String   `tfsdk:"password"`
+	TvCategory               types.String   `tfsdk:"tv_category"`
+	TvImportedCategory       types.String   `tfsdk:"tv_imported_category"`
+	RecentTvPriority         types.Int64    `tfsdk:"recent_tv_priority"`
+	OlderTvPriority          types.Int64    `tfsdk:"older_tv_priority"`
+	Priority                 types.Int64    `tfsdk:"priority"`
+	Port                     types.Int64    `tfsdk:"port"`
+	ID                       types.Int64    `tfsdk:"id"`
+	AddPaused                types.Bool     `tfsdk:"add_paused"`
+	UseSsl                   types.Bool     `tfsdk:"use_ssl"`
+	Enable                   types.Bool     `tfsdk:"enable"`
+	RemoveFailedDownloads    types.Bool     `tfsdk:"remove_failed_downloads"`
+	RemoveCompletedDownloads types.Bool     `tfsdk:"remove_completed_downloads"`
 }
 
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
@@ -111,7 +118,7 @@ func (r *DownloadClientDelugeResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientDelugeResourceName
 }
 
-func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DownloadClientDelugeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Deluge resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients) and [Deluge](https://wiki.servarr.com/sonarr/supported#deluge).",
 		Attributes: map[string]schema.Attribute{
@@ -210,6 +217,14 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -217,9 +232,18 @@ func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *DownloadClientDelugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, downloadClientDelugeResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientDeluge
@@ -230,20 +254,27 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Create, downloadClientDelugeResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -256,18 +287,33 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get DownloadClientDeluge current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(downloadClientDelugeResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, client.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, downloadClientSecretTest(ctx, r.client, *response), downloadClientDelugeResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -280,33 +326,54 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute, helpers.ClearedFields(client, downloadClientFields), downloadClientDelugeResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Update, downloadClientDelugeResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+downloadClientDelugeResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete DownloadClientDeluge current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientDelugeResourceName, err))
 
@@ -318,10 +385,19 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientDelugeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	downloadClientImporter(r.client, downloadClientDelugeImplementation).importState(ctx, r.auth, downloadClientDelugeResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientDelugeResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientDelugeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return downloadClientMover(ctx, downloadClientDelugeImplementation, func(client *DownloadClientResourceModel) interface{} {
+		moved := DownloadClientDeluge{Timeouts: client.Timeouts}
+		moved.fromDownloadClient(&client.DownloadClient)
+
+		return &moved
+	})
+}
+
 func (d *DownloadClientDeluge) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
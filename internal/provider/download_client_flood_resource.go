@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientFloodResource{}
 	_ resource.ResourceWithImportState = &DownloadClientFloodResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientFloodResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientFloodResource{}
 )
 
 func NewDownloadClientFloodResource() resource.Resource {
@@ -35,30 +38,34 @@ func NewDownloadClientFloodResource() resource.Resource {
 
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // DownloadClientFlood describes the download client data model.
 type DownloadClientFlood struct {
-	Tags                     types.Set    `tfsdk:"tags"`
-	FieldTags                types.Set    `tfsdk:"field_tags"`
-	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
-	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
-	Name                     types.String `tfsdk:"name"`
-	Host                     types.String `tfsdk:"host"`
-	URLBase                  types.String `tfsdk:"url_base"`
-	Username                 types.String `tfsdk:"username"`
-	Password                 types.String `tfsdk:"password"`
-	Destination              types.String `tfsdk:"destination"`
-	Priority                 types.Int64  `tfsdk:"priority"`
-	Port                     types.Int64  `tfsdk:"port"`
-	ID                       types.Int64  `tfsdk:"id"`
-	StartOnAdd               types.Bool   `tfsdk:"start_on_add"`
-	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
-	Enable                   types.Bool   `tfsdk:"enable"`
-	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
-	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	Tags                     types.Set      `tfsdk:"tags"`
+	FieldTags                types.Set      `tfsdk:"field_tags"`
+	AdditionalTags           types.Set      `tfsdk:"additional_tags"`
+	PostImportTags           types.Set      `tfsdk:"post_import_tags"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Name                     types.String   `tfsdk:"name"`
+	Host                     types.String   `tfsdk:"host"`
+	URLBase                  types.String   `tfsdk:"url_base"`
+	Username                 types.String   `tfsdk:"username"`
+	Password                 types.String   `tfsdk:"password"`
+	Destination              types.String   `tfsdk:"destination"`
+	Priority                 types.Int64    `tfsdk:"priority"`
+	Port                     types.Int64    `tfsdk:"port"`
+	ID                       types.Int64    `tfsdk:"id"`
+	StartOnAdd               types.Bool     `tfsdk:"start_on_add"`
+	UseSsl                   types.Bool     `tfsdk:"use_ssl"`
+	Enable                   types.Bool     `tfsdk:"enable"`
+	RemoveFailedDownloads    types.Bool     `tfsdk:"remove_failed_downloads"`
+	RemoveCompletedDownloads types.Bool     `tfsdk:"remove_completed_downloads"`
 }
 
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
@@ -112,7 +119,7 @@ func (r *DownloadClientFloodResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientFloodResourceName
 }
 
-func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DownloadClientFloodResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Flood resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients) and [Flood](https://wiki.servarr.com/sonarr/supported#flood).",
 		Attributes: map[string]schema.Attribute{
@@ -214,6 +221,14 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -221,9 +236,18 @@ func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *DownloadClientFloodResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, downloadClientFloodResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientFlood
@@ -234,20 +258,27 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Create, downloadClientFloodResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -260,18 +291,33 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(downloadClientFloodResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, client.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, downloadClientSecretTest(ctx, r.client, *response), downloadClientFloodResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -284,33 +330,54 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute, helpers.ClearedFields(client, downloadClientFields), downloadClientFloodResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Update, downloadClientFloodResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+downloadClientFloodResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete DownloadClientFlood current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientFloodResourceName, err))
 
@@ -322,10 +389,19 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	downloadClientImporter(r.client, downloadClientFloodImplementation).importState(ctx, r.auth, downloadClientFloodResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientFloodResource) MoveState(ctx context.Context) []resource.StateMover {
+	return downloadClientMover(ctx, downloadClientFloodImplementation, func(client *DownloadClientResourceModel) interface{} {
+		moved := DownloadClientFlood{Timeouts: client.Timeouts}
+		moved.fromDownloadClient(&client.DownloadClient)
+
+		return &moved
+	})
+}
+
 func (d *DownloadClientFlood) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientHadoukenResource{}
 	_ resource.ResourceWithImportState = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientHadoukenResource{}
 )
 
 func NewDownloadClientHadoukenResource() resource.Resource {
@@ -35,26 +38,30 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // DownloadClientHadouken describes the download client data model.
 type DownloadClientHadouken struct {
-	Tags                     types.Set    `tfsdk:"tags"`
-	Name                     types.String `tfsdk:"name"`
-	Host                     types.String `tfsdk:"host"`
-	URLBase                  types.String `tfsdk:"url_base"`
-	Username                 types.String `tfsdk:"username"`
-	Password                 types.String `tfsdk:"password"`
-	Category                 types.String `tfsdk:"category"`
-	Priority                 types.Int64  `tfsdk:"priority"`
-	Port                     types.Int64  `tfsdk:"port"`
-	ID                       types.Int64  `tfsdk:"id"`
-	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
-	Enable                   types.Bool   `tfsdk:"enable"`
-	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
-	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	Tags                     types.Set      `tfsdk:"tags"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Name                     types.String   `tfsdk:"name"`
+	Host                     types.String   `tfsdk:"host"`
+	URLBase                  types.String   `tfsdk:"url_base"`
+	Username                 types.String   `tfsdk:"username"`
+	Password                 types.String   `tfsdk:"password"`
+	Category                 types.String   `tfsdk:"category"`
+	Priority                 types.Int64    `tfsdk:"priority"`
+	Port                     types.Int64    `tfsdk:"port"`
+	ID                       types.Int64    `tfsdk:"id"`
+	UseSsl                   types.Bool     `tfsdk:"use_ssl"`
+	Enable                   types.Bool     `tfsdk:"enable"`
+	RemoveFailedDownloads    types.Bool     `tfsdk:"remove_failed_downloads"`
+	RemoveCompletedDownloads types.Bool     `tfsdk:"remove_completed_downloads"`
 }
 
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
@@ -100,7 +107,7 @@ func (r *DownloadClientHadoukenResource) Metadata(_ context.Context, req resourc
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientHadoukenResourceName
 }
 
-func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DownloadClientHadoukenResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Hadouken resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients) and [Hadouken](https://wiki.servarr.com/sonarr/supported#hadouken).",
 		Attributes: map[string]schema.Attribute{
@@ -177,6 +184,14 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -184,9 +199,18 @@ func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req reso
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *DownloadClientHadoukenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, downloadClientHadoukenResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientHadouken
@@ -197,20 +221,27 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Create, downloadClientHadoukenResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -223,18 +254,33 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(downloadClientHadoukenResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, client.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, downloadClientSecretTest(ctx, r.client, *response), downloadClientHadoukenResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -247,33 +293,54 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute, helpers.ClearedFields(client, downloadClientFields), downloadClientHadoukenResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Update, downloadClientHadoukenResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+downloadClientHadoukenResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete DownloadClientHadouken current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientHadoukenResourceName, err))
 
@@ -285,10 +352,19 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	downloadClientImporter(r.client, downloadClientHadoukenImplementation).importState(ctx, r.auth, downloadClientHadoukenResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientHadoukenResource) MoveState(ctx context.Context) []resource.StateMover {
+	return downloadClientMover(ctx, downloadClientHadoukenImplementation, func(client *DownloadClientResourceModel) interface{} {
+		moved := DownloadClientHadouken{Timeouts: client.Timeouts}
+		moved.fromDownloadClient(&client.DownloadClient)
+
+		return &moved
+	})
+}
+
 func (d *DownloadClientHadouken) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
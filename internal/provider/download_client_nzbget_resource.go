@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -29,6 +30,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientNzbgetResource{}
 	_ resource.ResourceWithImportState = &DownloadClientNzbgetResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientNzbgetResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientNzbgetResource{}
 )
 
 func NewDownloadClientNzbgetResource() resource.Resource {
@@ -37,29 +40,33 @@ func NewDownloadClientNzbgetResource() resource.Resource {
 
 // DownloadClientNzbgetResource defines the download client implementation.
 type DownloadClientNzbgetResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // DownloadClientNzbget describes the download client data model.
 type DownloadClientNzbget struct {
-	Tags                     types.Set    `tfsdk:"tags"`
-	Name                     types.String `tfsdk:"name"`
-	Host                     types.String `tfsdk:"host"`
-	URLBase                  types.String `tfsdk:"url_base"`
-	Username                 types.String `tfsdk:"username"`
-	Password                 types.String `tfsdk:"password"`
-	TvCategory               types.String `tfsdk:"tv_category"`
-	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
-	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
-	Priority                 types.Int64  `tfsdk:"priority"`
-	Port                     types.Int64  `tfsdk:"port"`
-	ID                       types.Int64  `tfsdk:"id"`
-	AddPaused                types.Bool   `tfsdk:"add_paused"`
-	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
-	Enable                   types.Bool   `tfsdk:"enable"`
-	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
-	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	Tags                     types.Set      `tfsdk:"tags"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Name                     types.String   `tfsdk:"name"`
+	Host                     types.String   `tfsdk:"host"`
+	URLBase                  types.String   `tfsdk:"url_base"`
+	Username                 types.String   `tfsdk:"username"`
+	Password                 types.String   `tfsdk:"password"`
+	TvCategory               types.String   `tfsdk:"tv_category"`
+	RecentTvPriority         types.Int64    `tfsdk:"recent_tv_priority"`
+	OlderTvPriority          types.Int64    `tfsdk:"older_tv_priority"`
+	Priority                 types.Int64    `tfsdk:"priority"`
+	Port                     types.Int64    `tfsdk:"port"`
+	ID                       types.Int64    `tfsdk:"id"`
+	AddPaused                types.Bool     `tfsdk:"add_paused"`
+	UseSsl                   types.Bool     `tfsdk:"use_ssl"`
+	Enable                   types.Bool     `tfsdk:"enable"`
+	RemoveFailedDownloads    types.Bool     `tfsdk:"remove_failed_downloads"`
+	RemoveCompletedDownloads types.Bool     `tfsdk:"remove_completed_downloads"`
 }
 
 func (d DownloadClientNzbget) toDownloadClient() *DownloadClient {
@@ -111,7 +118,7 @@ func (r *DownloadClientNzbgetResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientNzbgetResourceName
 }
 
-func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DownloadClientNzbgetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client NZBGet resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients) and [NZBGet](https://wiki.servarr.com/sonarr/supported#nzbget).",
 		Attributes: map[string]schema.Attribute{
@@ -211,6 +218,14 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -218,9 +233,18 @@ func (r *DownloadClientNzbgetResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *DownloadClientNzbgetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, downloadClientNzbgetResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientNzbget
@@ -231,20 +255,27 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbgetResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Create, downloadClientNzbgetResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+downloadClientNzbgetResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -257,18 +288,33 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get DownloadClientNzbget current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(downloadClientNzbgetResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbgetResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, client.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, downloadClientSecretTest(ctx, r.client, *response), downloadClientNzbgetResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientNzbgetResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -281,33 +327,54 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute, helpers.ClearedFields(client, downloadClientFields), downloadClientNzbgetResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbgetResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Update, downloadClientNzbgetResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+downloadClientNzbgetResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete DownloadClientNzbget current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientNzbgetResourceName, err))
 
@@ -319,10 +386,19 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	downloadClientImporter(r.client, downloadClientNzbgetImplementation).importState(ctx, r.auth, downloadClientNzbgetResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientNzbgetResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientNzbgetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return downloadClientMover(ctx, downloadClientNzbgetImplementation, func(client *DownloadClientResourceModel) interface{} {
+		moved := DownloadClientNzbget{Timeouts: client.Timeouts}
+		moved.fromDownloadClient(&client.DownloadClient)
+
+		return &moved
+	})
+}
+
 func (d *DownloadClientNzbget) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
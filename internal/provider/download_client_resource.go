@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -20,12 +21,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const downloadClientResourceName = "download_client"
+const (
+	downloadClientResourceName = "download_client"
+	// intialStateDeprecation is the deprecation message of the misspelled intial_state attribute.
+	intialStateDeprecation = "Use initial_state instead. intial_state will be removed in the next major release."
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientResource{}
-	_ resource.ResourceWithImportState = &DownloadClientResource{}
+	_ resource.Resource                 = &DownloadClientResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientResource{}
+	_ resource.ResourceWithModifyPlan   = &DownloadClientResource{}
 )
 
 var downloadClientFields = helpers.Fields{
@@ -43,8 +50,11 @@ func NewDownloadClientResource() resource.Resource {
 
 // DownloadClientResource defines the download client implementation.
 type DownloadClientResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // DownloadClient describes the download client data model.
@@ -75,8 +85,9 @@ type DownloadClient struct {
 	URLBase                  types.String `tfsdk:"url_base"`
 	APIKey                   types.String `tfsdk:"api_key"`
 	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
-	IntialState              types.Int64  `tfsdk:"intial_state"`
+	IntialState              types.Int64  `tfsdk:"-"`
 	InitialState             types.Int64  `tfsdk:"initial_state"`
+	DeprecatedIntialState    types.Int64  `tfsdk:"intial_state"`
 	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
@@ -94,6 +105,12 @@ type DownloadClient struct {
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
 }
 
+// DownloadClientResourceModel describes the download client resource data model, including timeouts.
+type DownloadClientResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	DownloadClient
+}
+
 func (d DownloadClient) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -123,8 +140,8 @@ func (d DownloadClient) getType() attr.Type {
 			"url_base":                   types.StringType,
 			"api_key":                    types.StringType,
 			"recent_tv_priority":         types.Int64Type,
-			"intial_state":               types.Int64Type,
 			"initial_state":              types.Int64Type,
+			"intial_state":               types.Int64Type,
 			"older_tv_priority":          types.Int64Type,
 			"priority":                   types.Int64Type,
 			"port":                       types.Int64Type,
@@ -147,8 +164,9 @@ func (r *DownloadClientResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientResourceName
 }
 
-func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DownloadClientResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nGeneric Download Client resource. When possible use a specific resource instead.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients).",
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
@@ -266,17 +284,21 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 			"initial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
+				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause, `3` Stop (uTorrent only).",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.Int64{
-					int64validator.OneOf(0, 1),
+					int64validator.OneOf(0, 1, 2, 3),
 				},
 			},
 			"intial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
+				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop. Deprecated, use `initial_state` instead.",
+				DeprecationMessage:  intialStateDeprecation,
 				Optional:            true,
-				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2, 3),
+					int64validator.ConflictsWith(path.MatchRoot("initial_state")),
+				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
@@ -385,6 +407,14 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -392,12 +422,21 @@ func (r *DownloadClientResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *DownloadClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, downloadClientResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var client *DownloadClient
+	var client *DownloadClientResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -405,29 +444,36 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Create, downloadClientResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	state := DownloadClientResourceModel{Timeouts: client.Timeouts}
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var client *DownloadClient
+	var client *DownloadClientResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
 
@@ -435,27 +481,42 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get DownloadClient current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(downloadClientResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, client.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, downloadClientSecretTest(ctx, r.client, *response), downloadClientResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	state := DownloadClientResourceModel{Timeouts: client.Timeouts}
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var client *DownloadClient
+	var client *DownloadClientResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
 
@@ -463,37 +524,58 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute, helpers.ClearedFields(client, downloadClientFields), downloadClientResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Update, downloadClientResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+downloadClientResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state DownloadClient
+	state := DownloadClientResourceModel{Timeouts: client.Timeouts}
 
-	state.writeSensitive(client)
+	state.writeSensitive(&client.DownloadClient)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete DownloadClient current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientResourceName, err))
 
@@ -505,10 +587,17 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	downloadClientImporter(r.client, "").importState(ctx, r.auth, downloadClientResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// intial_state values are copied to initial_state, intial_state is kept as deprecated alias.
+		0: stateUpgrader(ctx, r, stateUpgrade{aliases: map[string]string{"intial_state": "initial_state"}}),
+	}
+}
+
 func (d *DownloadClient) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
 	var localDiag diag.Diagnostics
 
@@ -528,6 +617,12 @@ func (d *DownloadClient) write(ctx context.Context, downloadClient *sonarr.Downl
 	d.FieldTags = types.SetValueMust(types.StringType, nil)
 	d.PostImportTags = types.SetValueMust(types.StringType, nil)
 	helpers.WriteFields(ctx, d, downloadClient.GetFields(), downloadClientFields)
+
+	// uTorrent misspells the initial state field, exposed as initial_state.
+	if !d.IntialState.IsNull() {
+		d.InitialState = d.IntialState
+		d.IntialState = types.Int64Null()
+	}
 }
 
 func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.DownloadClientResource {
@@ -542,11 +637,29 @@ func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *son
 	client.SetName(d.Name.ValueString())
 	client.SetProtocol(sonarr.DownloadProtocol(d.Protocol.ValueString()))
 	diags.Append(d.Tags.ElementsAs(ctx, &client.Tags, true)...)
-	client.SetFields(helpers.ReadFields(ctx, d, downloadClientFields))
+
+	fields := *d
+	if !d.DeprecatedIntialState.IsNull() {
+		fields.InitialState = d.DeprecatedIntialState
+	}
+
+	if d.Implementation.ValueString() == downloadClientUtorrentImplementation {
+		fields.IntialState = fields.InitialState
+		fields.InitialState = types.Int64Null()
+	}
+
+	client.SetFields(helpers.ReadFields(ctx, &fields, downloadClientFields))
 
 	return client
 }
 
+// writeIntialState exposes the initial state of uTorrent clients through the deprecated intial_state attribute.
+func (d *DownloadClient) writeIntialState() {
+	if d.Implementation.ValueString() == downloadClientUtorrentImplementation {
+		d.DeprecatedIntialState = d.InitialState
+	}
+}
+
 // writeSensitive copy sensitive data from another resource.
 func (d *DownloadClient) writeSensitive(client *DownloadClient) {
 	if !client.Password.IsUnknown() {
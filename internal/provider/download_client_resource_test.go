@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -41,6 +43,25 @@ func TestAccDownloadClientResource(t *testing.T) {
 					resource.TestCheckResourceAttr("sonarr_download_client.test", "enable", "true"),
 				),
 			},
+			// Out of band deletion testing
+			{
+				Config: testAccDownloadClientResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceDisappears("sonarr_download_client.test", func(ctx context.Context, client *sonarr.APIClient, id int32) error {
+						_, err := client.DownloadClientAPI.DeleteDownloadClient(ctx, id).Execute()
+
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Re-create after out of band deletion
+			{
+				Config: testAccDownloadClientResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_download_client.test", "id"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "sonarr_download_client.test",
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientTorrentBlackholeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientTorrentBlackholeResource{}
+	_ resource.ResourceWithMoveState   = &DownloadClientTorrentBlackholeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientTorrentBlackholeResource{}
 )
 
 func NewDownloadClientTorrentBlackholeResource() resource.Resource {
@@ -35,24 +38,27 @@ func NewDownloadClientTorrentBlackholeResource() resource.Resource {
 
 // DownloadClientTorrentBlackholeResource defines the download client implementation.
 type DownloadClientTorrentBlackholeResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // DownloadClientTorrentBlackhole describes the download client data model.
 type DownloadClientTorrentBlackhole struct {
-	Tags                     types.Set    `tfsdk:"tags"`
-	Name                     types.String `tfsdk:"name"`
-	TorrentFolder            types.String `tfsdk:"torrent_folder"`
-	WatchFolder              types.String `tfsdk:"watch_folder"`
-	MagnetFileExtension      types.String `tfsdk:"magnet_file_extension"`
-	Priority                 types.Int64  `tfsdk:"priority"`
-	ID                       types.Int64  `tfsdk:"id"`
-	Enable                   types.Bool   `tfsdk:"enable"`
-	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
-	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
-	SaveMagnetFiles          types.Bool   `tfsdk:"save_magnet_files"`
-	ReadOnly                 types.Bool   `tfsdk:"read_only"`
+	Tags                     types.Set      `tfsdk:"tags"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Name                     types.String   `tfsdk:"name"`
+	TorrentFolder            types.String   `tfsdk:"torrent_folder"`
+	WatchFolder              types.String   `tfsdk:"watch_folder"`
+	MagnetFileExtension      types.String   `tfsdk:"magnet_file_extension"`
+	Priority                 types.Int64    `tfsdk:"priority"`
+	ID                       types.Int64    `tfsdk:"id"`
+	Enable                   types.Bool     `tfsdk:"enable"`
+	RemoveFailedDownloads    types.Bool     `tfsdk:"remove_failed_downloads"`
+	RemoveCompletedDownloads types.Bool     `tfsdk:"remove_completed_downloads"`
+	SaveMagnetFiles          types.Bool     `tfsdk:"save_magnet_files"`
+	ReadOnly                 types.Bool     `tfsdk:"read_only"`
 }
 
 func (d DownloadClientTorrentBlackhole) toDownloadClient() *DownloadClient {
@@ -94,7 +100,7 @@ func (r *DownloadClientTorrentBlackholeResource) Metadata(_ context.Context, req
 	resp.TypeName = req.ProviderTypeName + "_" + downloadClientTorrentBlackholeResourceName
 }
 
-func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DownloadClientTorrentBlackholeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Torrent Blackhole resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients) and [TorrentBlackhole](https://wiki.servarr.com/sonarr/supported#torrentblackhole).",
 		Attributes: map[string]schema.Attribute{
@@ -160,6 +166,14 @@ func (r *DownloadClientTorrentBlackholeResource) Schema(_ context.Context, _ res
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -167,9 +181,17 @@ func (r *DownloadClientTorrentBlackholeResource) Configure(ctx context.Context,
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *DownloadClientTorrentBlackholeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, downloadClientTorrentBlackholeResourceName, req.State, resp.Plan)...)
+}
+
 func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientTorrentBlackhole
@@ -180,12 +202,17 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new DownloadClientTorrentBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientTorrentBlackholeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Create, downloadClientTorrentBlackholeResourceName, err)
 
 		return
 	}
@@ -194,6 +221,7 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -206,9 +234,19 @@ func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req r
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get DownloadClientTorrentBlackhole current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(downloadClientTorrentBlackholeResourceName, client.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientTorrentBlackholeResourceName, err))
 
 		return
@@ -218,6 +256,7 @@ func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req r
 	// Map response body to resource schema attribute
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -230,12 +269,24 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, client.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update DownloadClientTorrentBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.DownloadClientAPI.GetDownloadClientById(ctx, int32(client.ID.ValueInt64())).Execute, helpers.ClearedFields(client, downloadClientFields), downloadClientTorrentBlackholeResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(ctx, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientTorrentBlackholeResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, downloadClientFields, helpers.Update, downloadClientTorrentBlackholeResourceName, err)
 
 		return
 	}
@@ -244,19 +295,27 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 	// Generate resource state struct
 	client.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *DownloadClientTorrentBlackholeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete DownloadClientTorrentBlackhole current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientTorrentBlackholeResourceName, err))
 
@@ -268,10 +327,19 @@ func (r *DownloadClientTorrentBlackholeResource) Delete(ctx context.Context, req
 }
 
 func (r *DownloadClientTorrentBlackholeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	downloadClientImporter(r.client, downloadClientTorrentBlackholeImplementation).importState(ctx, r.auth, downloadClientTorrentBlackholeResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+downloadClientTorrentBlackholeResourceName+": "+req.ID)
 }
 
+func (r *DownloadClientTorrentBlackholeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return downloadClientMover(ctx, downloadClientTorrentBlackholeImplementation, func(client *DownloadClientResourceModel) interface{} {
+		moved := DownloadClientTorrentBlackhole{Timeouts: client.Timeouts}
+		moved.fromDownloadClient(&client.DownloadClient)
+
+		return &moved
+	})
+}
+
 func (d *DownloadClientTorrentBlackhole) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
 	genericDownloadClient := d.toDownloadClient()
 	genericDownloadClient.write(ctx, downloadClient, diags)
@@ -138,11 +138,12 @@ func (d *DownloadClientsDataSource) Schema(_ context.Context, _ datasource.Schem
 							Computed:            true,
 						},
 						"initial_state": schema.Int64Attribute{
-							MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
+							MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause, `3` Stop (uTorrent only).",
 							Computed:            true,
 						},
 						"intial_state": schema.Int64Attribute{
-							MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
+							MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop. Deprecated, use `initial_state` instead.",
+							DeprecationMessage:  intialStateDeprecation,
 							Computed:            true,
 						},
 						"host": schema.StringAttribute{
@@ -247,7 +248,7 @@ func (d *DownloadClientsDataSource) Configure(ctx context.Context, req datasourc
 
 func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get download clients current value
-	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(d.auth).Execute()
+	response, _, err := d.client.DownloadClientAPI.ListDownloadClient(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientsDataSourceName, err))
 
@@ -259,6 +260,7 @@ func (d *DownloadClientsDataSource) Read(ctx context.Context, _ datasource.ReadR
 	clients := make([]DownloadClient, len(response))
 	for i, d := range response {
 		clients[i].write(ctx, &d, &resp.Diagnostics)
+		clients[i].writeIntialState()
 	}
 
 	clientList, diags := types.SetValueFrom(ctx, DownloadClient{}.getType(), clients)
@@ -0,0 +1,731 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// exporter exports the Sonarr objects of a kind.
+type exporter interface {
+	export(ctx context.Context, client *sonarr.APIClient, files *exportFiles, filter exportFilter, diags *diag.Diagnostics)
+}
+
+// exportType is a resource type objects are exported as, with the write method mapping them to its model.
+type exportType[T any] struct {
+	resource func() resource.Resource
+	write    func(context.Context, *T, *diag.Diagnostics) interface{}
+}
+
+// exportKind lists the objects of a kind, exported with the resource type matching their implementation,
+// or the generic one (the "" key) when there is no typed resource.
+type exportKind[T any] struct {
+	list           func(context.Context, *sonarr.APIClient) ([]T, *http.Response, error)
+	id             func(*T) int32
+	label          func(*T) string
+	implementation func(*T) string
+	tags           func(*T) []int32
+	types          map[string]exportType[T]
+}
+
+// ExportFilter restricts the exported objects, its zero value exports everything.
+type ExportFilter struct {
+	// Name matches the name (or title, path) of the objects.
+	Name *regexp.Regexp
+	// Implementation matches the implementation of download clients, import lists, indexers, metadata and notifications.
+	Implementation string
+	// Tag is the label of a tag the objects must have.
+	Tag string
+	// Types lists the resource types to export (e.g. `sonarr_series`).
+	Types []string
+}
+
+// exportFilter is the ExportFilter with the tag resolved to its ID.
+type exportFilter struct {
+	ExportFilter
+	tag int32
+}
+
+// Export renders the objects of a live Sonarr as Terraform configuration, along with the import blocks adopting them.
+// It returns the content of the files to write, one per resource type.
+func Export(ctx context.Context, client *sonarr.APIClient, filter ExportFilter) (map[string][]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resolved := exportFilter{ExportFilter: filter}
+	if filter.Tag != "" {
+		tags, _, err := client.TagAPI.ListTag(ctx).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, tagResourceName, err))
+
+			return nil, diags
+		}
+
+		found := false
+
+		for _, t := range tags {
+			if strings.EqualFold(t.GetLabel(), filter.Tag) {
+				resolved.tag = t.GetId()
+				found = true
+			}
+		}
+
+		if !found {
+			diags.AddError(helpers.ClientError, fmt.Sprintf("Unable to find %s with label '%s'", tagResourceName, filter.Tag))
+
+			return nil, diags
+		}
+	}
+
+	files := &exportFiles{
+		files:  make(map[string]*hclwrite.File),
+		labels: make(map[string]bool),
+	}
+
+	for _, kind := range exportKinds() {
+		kind.export(ctx, client, files, resolved, &diags)
+	}
+
+	output := make(map[string][]byte, len(files.files))
+	for name, file := range files.files {
+		output[name] = file.Bytes()
+	}
+
+	return output, diags
+}
+
+func (k exportKind[T]) export(ctx context.Context, client *sonarr.APIClient, files *exportFiles, filter exportFilter, diags *diag.Diagnostics) {
+	if !k.filtered(ctx, filter) {
+		return
+	}
+
+	objects, _, err := k.list(ctx, client)
+	if err != nil {
+		name := resourceTypeName(ctx, k.types[""].resource())
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, strings.TrimPrefix(name, providerTypeName+"_"), err))
+
+		return
+	}
+
+	for i := range objects {
+		object := &objects[i]
+
+		target := k.types[""]
+		if k.implementation != nil {
+			if typed, ok := k.types[k.implementation(object)]; ok {
+				target = typed
+			}
+		}
+
+		label := ""
+		if k.label != nil {
+			label = k.label(object)
+		}
+
+		if !k.matches(ctx, object, target, label, filter) {
+			continue
+		}
+
+		model := target.write(ctx, object, diags)
+		files.add(ctx, target.resource(), label, k.id(object), model, diags)
+	}
+}
+
+// filtered tells whether the filter can match objects of the kind, to skip listing the others.
+func (k exportKind[T]) filtered(ctx context.Context, filter exportFilter) bool {
+	if (filter.Name != nil && k.label == nil) || (filter.Implementation != "" && k.implementation == nil) || (filter.Tag != "" && k.tags == nil) {
+		return false
+	}
+
+	if len(filter.Types) == 0 {
+		return true
+	}
+
+	for _, t := range k.types {
+		if slices.Contains(filter.Types, resourceTypeName(ctx, t.resource())) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches tells whether the object, exported as target, matches the filter.
+func (k exportKind[T]) matches(ctx context.Context, object *T, target exportType[T], label string, filter exportFilter) bool {
+	if len(filter.Types) != 0 && !slices.Contains(filter.Types, resourceTypeName(ctx, target.resource())) {
+		return false
+	}
+
+	if filter.Name != nil && !filter.Name.MatchString(label) {
+		return false
+	}
+
+	if filter.Implementation != "" && !strings.EqualFold(k.implementation(object), filter.Implementation) {
+		return false
+	}
+
+	return filter.Tag == "" || slices.Contains(k.tags(object), filter.tag)
+}
+
+// exportWrite returns the write function of the model M, filling it from the Sonarr object.
+func exportWrite[T, M any, P interface {
+	*M
+	write(context.Context, *T, *diag.Diagnostics)
+}]() func(context.Context, *T, *diag.Diagnostics) interface{} {
+	return func(ctx context.Context, object *T, diags *diag.Diagnostics) interface{} {
+		model := P(new(M))
+		model.write(ctx, object, diags)
+
+		return model
+	}
+}
+
+// exportPlainWrite returns the write function of the model M, for models which cannot fail on write.
+func exportPlainWrite[T, M any, P interface {
+	*M
+	write(*T)
+}]() func(context.Context, *T, *diag.Diagnostics) interface{} {
+	return func(_ context.Context, object *T, _ *diag.Diagnostics) interface{} {
+		model := P(new(M))
+		model.write(object)
+
+		return model
+	}
+}
+
+// exportSingleton lists the single object of a configuration resource (e.g. host).
+func exportSingleton[T any](get func(*sonarr.APIClient, context.Context) (*T, *http.Response, error)) func(context.Context, *sonarr.APIClient) ([]T, *http.Response, error) {
+	return func(ctx context.Context, client *sonarr.APIClient) ([]T, *http.Response, error) {
+		object, httpResp, err := get(client, ctx)
+		if err != nil {
+			return nil, httpResp, err
+		}
+
+		return []T{*object}, httpResp, nil
+	}
+}
+
+// exportFiles collects the generated configuration, keyed by file name.
+type exportFiles struct {
+	files  map[string]*hclwrite.File
+	labels map[string]bool
+}
+
+// add appends the resource block and the related import block to the file of the resource type.
+func (e *exportFiles) add(ctx context.Context, r resource.Resource, label string, id int32, model interface{}, diags *diag.Diagnostics) {
+	typeName := resourceTypeName(ctx, r)
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	diags.Append(schemaResp.Diagnostics...)
+
+	label = e.uniqueLabel(typeName, exportLabel(label, typeName, id))
+
+	file, ok := e.files[typeName+".tf"]
+	if !ok {
+		file = hclwrite.NewEmptyFile()
+		e.files[typeName+".tf"] = file
+	} else {
+		file.Body().AppendNewline()
+	}
+
+	importBody := file.Body().AppendNewBlock("import", nil).Body()
+	importBody.SetAttributeTraversal("to", hcl.Traversal{hcl.TraverseRoot{Name: typeName}, hcl.TraverseAttr{Name: label}})
+	importBody.SetAttributeValue("id", cty.StringVal(strconv.Itoa(int(id))))
+	file.Body().AppendNewline()
+
+	resourceBody := file.Body().AppendNewBlock("resource", []string{typeName, label}).Body()
+	exportAttributes(ctx, resourceBody, schemaResp.Schema.Attributes, reflect.ValueOf(model), diags)
+}
+
+// uniqueLabel suffixes the label when it is already used by another resource of the same type.
+func (e *exportFiles) uniqueLabel(typeName, label string) string {
+	unique := label
+	for i := 2; e.labels[typeName+"."+unique]; i++ {
+		unique = label + "_" + strconv.Itoa(i)
+	}
+
+	e.labels[typeName+"."+unique] = true
+
+	return unique
+}
+
+// exportAttributes writes the configurable attributes of the model.
+// Computed only attributes, e.g. `id`, and null values are left out.
+func exportAttributes(ctx context.Context, body *hclwrite.Body, attributes map[string]schema.Attribute, model reflect.Value, diags *diag.Diagnostics) {
+	model = reflect.Indirect(model)
+
+	for i := 0; i < model.NumField(); i++ {
+		field := model.Type().Field(i)
+		if field.Anonymous {
+			exportAttributes(ctx, body, attributes, model.Field(i), diags)
+
+			continue
+		}
+
+		name := field.Tag.Get("tfsdk")
+
+		attribute := attributes[name]
+		if !configurable(attribute) {
+			continue
+		}
+
+		value, ok := model.Field(i).Interface().(attr.Value)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		terraformValue, err := value.ToTerraformValue(ctx)
+		if err != nil {
+			diags.AddError(helpers.ResourceError, fmt.Sprintf("Unable to export %s, got error: %s", name, err))
+
+			continue
+		}
+
+		if secret, ok := value.(types.String); ok && attribute.IsSensitive() && secret.ValueString() == helpers.SensitiveValue {
+			body.AppendUnstructuredTokens(hclwrite.Tokens{{
+				Type:  hclsyntax.TokenComment,
+				Bytes: []byte("# Masked by Sonarr: keep it to leave the secret unchanged, or set the actual value.\n"),
+			}})
+		}
+
+		body.SetAttributeValue(name, exportValue(terraformValue, nestedAttributes(attribute)))
+	}
+}
+
+// exportValue converts a Terraform value to its HCL expression.
+// Collections are rendered as tuples and objects leave out their null attributes,
+// as well as the computed only ones when nested attributes are given.
+func exportValue(value tftypes.Value, nested map[string]schema.Attribute) cty.Value {
+	if value.IsNull() || !value.IsKnown() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elements []tftypes.Value
+
+		_ = value.As(&elements)
+		if len(elements) == 0 {
+			return cty.EmptyTupleVal
+		}
+
+		output := make([]cty.Value, len(elements))
+		for i, element := range elements {
+			output[i] = exportValue(element, nested)
+		}
+
+		return cty.TupleVal(output)
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+
+		_ = value.As(&elements)
+		output := make(map[string]cty.Value, len(elements))
+
+		for key, element := range elements {
+			output[key] = exportValue(element, nested)
+		}
+
+		return cty.ObjectVal(output)
+	case tftypes.Object:
+		var attributes map[string]tftypes.Value
+
+		_ = value.As(&attributes)
+		output := make(map[string]cty.Value, len(attributes))
+
+		for name, attribute := range attributes {
+			if attribute.IsNull() {
+				continue
+			}
+
+			if nested == nil {
+				output[name] = exportValue(attribute, nil)
+			} else if configurable(nested[name]) {
+				output[name] = exportValue(attribute, nestedAttributes(nested[name]))
+			}
+		}
+
+		return cty.ObjectVal(output)
+	}
+
+	switch {
+	case value.Type().Equal(tftypes.String):
+		var s string
+
+		_ = value.As(&s)
+
+		return cty.StringVal(s)
+	case value.Type().Equal(tftypes.Number):
+		n := new(big.Float)
+
+		_ = value.As(&n)
+
+		return cty.NumberVal(n)
+	case value.Type().Equal(tftypes.Bool):
+		var b bool
+
+		_ = value.As(&b)
+
+		return cty.BoolVal(b)
+	default:
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+}
+
+// configurable checks if the attribute can be set in the configuration.
+func configurable(attribute schema.Attribute) bool {
+	return attribute != nil && (attribute.IsRequired() || attribute.IsOptional())
+}
+
+// nestedAttributes returns the attributes of a nested attribute object, if any.
+func nestedAttributes(attribute schema.Attribute) map[string]schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return a.Attributes
+	case schema.ListNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.SetNestedAttribute:
+		return a.NestedObject.Attributes
+	case schema.MapNestedAttribute:
+		return a.NestedObject.Attributes
+	default:
+		return nil
+	}
+}
+
+// exportLabel turns the object name into a resource label, e.g. `My Client` into `my_client`.
+// Objects without name are labelled after their ID.
+func exportLabel(name, typeName string, id int32) string {
+	var label strings.Builder
+
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9'):
+			label.WriteRune(r)
+		case label.Len() > 0 && !strings.HasSuffix(label.String(), "_"):
+			label.WriteByte('_')
+		}
+	}
+
+	output := strings.TrimSuffix(label.String(), "_")
+	if output == "" {
+		output = strconv.Itoa(int(id))
+	}
+
+	if output[0] >= '0' && output[0] <= '9' {
+		output = strings.TrimPrefix(typeName, providerTypeName+"_") + "_" + output
+	}
+
+	return output
+}
+
+func resourceTypeName(ctx context.Context, r resource.Resource) string {
+	resp := resource.MetadataResponse{}
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+
+	return resp.TypeName
+}
+
+// exportKinds returns the object kinds supported by the provider.
+func exportKinds() []exporter {
+	return []exporter{
+		exportKind[sonarr.TagResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.TagResource, *http.Response, error) {
+				return c.TagAPI.ListTag(ctx).Execute()
+			},
+			id:    (*sonarr.TagResource).GetId,
+			label: (*sonarr.TagResource).GetLabel,
+			types: map[string]exportType[sonarr.TagResource]{
+				"": {resource: NewTagResource, write: exportPlainWrite[sonarr.TagResource, Tag]()},
+			},
+		},
+		exportKind[sonarr.AutoTaggingResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.AutoTaggingResource, *http.Response, error) {
+				return c.AutoTaggingAPI.ListAutoTagging(ctx).Execute()
+			},
+			id:    (*sonarr.AutoTaggingResource).GetId,
+			label: (*sonarr.AutoTaggingResource).GetName,
+			tags:  (*sonarr.AutoTaggingResource).GetTags,
+			types: map[string]exportType[sonarr.AutoTaggingResource]{
+				"": {resource: NewAutoTagResource, write: exportWrite[sonarr.AutoTaggingResource, AutoTag]()},
+			},
+		},
+		exportKind[sonarr.CustomFormatResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.CustomFormatResource, *http.Response, error) {
+				return c.CustomFormatAPI.ListCustomFormat(ctx).Execute()
+			},
+			id:    (*sonarr.CustomFormatResource).GetId,
+			label: (*sonarr.CustomFormatResource).GetName,
+			types: map[string]exportType[sonarr.CustomFormatResource]{
+				"": {resource: NewCustomFormatResource, write: exportWrite[sonarr.CustomFormatResource, CustomFormat]()},
+			},
+		},
+		exportKind[sonarr.QualityProfileResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.QualityProfileResource, *http.Response, error) {
+				return c.QualityProfileAPI.ListQualityProfile(ctx).Execute()
+			},
+			id:    (*sonarr.QualityProfileResource).GetId,
+			label: (*sonarr.QualityProfileResource).GetName,
+			types: map[string]exportType[sonarr.QualityProfileResource]{
+				"": {resource: NewQualityProfileResource, write: exportWrite[sonarr.QualityProfileResource, QualityProfile]()},
+			},
+		},
+		exportKind[sonarr.QualityDefinitionResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.QualityDefinitionResource, *http.Response, error) {
+				return c.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
+			},
+			id:    (*sonarr.QualityDefinitionResource).GetId,
+			label: (*sonarr.QualityDefinitionResource).GetTitle,
+			types: map[string]exportType[sonarr.QualityDefinitionResource]{
+				"": {resource: NewQualityDefinitionResource, write: exportPlainWrite[sonarr.QualityDefinitionResource, QualityDefinition]()},
+			},
+		},
+		exportKind[sonarr.DelayProfileResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.DelayProfileResource, *http.Response, error) {
+				return c.DelayProfileAPI.ListDelayProfile(ctx).Execute()
+			},
+			id:   (*sonarr.DelayProfileResource).GetId,
+			tags: (*sonarr.DelayProfileResource).GetTags,
+			types: map[string]exportType[sonarr.DelayProfileResource]{
+				"": {resource: NewDelayProfileResource, write: exportWrite[sonarr.DelayProfileResource, DelayProfile]()},
+			},
+		},
+		exportKind[sonarr.ReleaseProfileResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.ReleaseProfileResource, *http.Response, error) {
+				return c.ReleaseProfileAPI.ListReleaseProfile(ctx).Execute()
+			},
+			id:    (*sonarr.ReleaseProfileResource).GetId,
+			label: (*sonarr.ReleaseProfileResource).GetName,
+			tags:  (*sonarr.ReleaseProfileResource).GetTags,
+			types: map[string]exportType[sonarr.ReleaseProfileResource]{
+				"": {resource: NewReleaseProfileResource, write: exportWrite[sonarr.ReleaseProfileResource, ReleaseProfile]()},
+			},
+		},
+		exportKind[sonarr.RootFolderResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.RootFolderResource, *http.Response, error) {
+				return c.RootFolderAPI.ListRootFolder(ctx).Execute()
+			},
+			id:    (*sonarr.RootFolderResource).GetId,
+			label: (*sonarr.RootFolderResource).GetPath,
+			types: map[string]exportType[sonarr.RootFolderResource]{
+				"": {resource: NewRootFolderResource, write: exportWrite[sonarr.RootFolderResource, RootFolder]()},
+			},
+		},
+		exportKind[sonarr.RemotePathMappingResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.RemotePathMappingResource, *http.Response, error) {
+				return c.RemotePathMappingAPI.ListRemotePathMapping(ctx).Execute()
+			},
+			id:    (*sonarr.RemotePathMappingResource).GetId,
+			label: (*sonarr.RemotePathMappingResource).GetHost,
+			types: map[string]exportType[sonarr.RemotePathMappingResource]{
+				"": {resource: NewRemotePathMappingResource, write: exportPlainWrite[sonarr.RemotePathMappingResource, RemotePathMapping]()},
+			},
+		},
+		exportKind[sonarr.SeriesResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.SeriesResource, *http.Response, error) {
+				return c.SeriesAPI.ListSeries(ctx).Execute()
+			},
+			id:    (*sonarr.SeriesResource).GetId,
+			label: (*sonarr.SeriesResource).GetTitle,
+			tags:  (*sonarr.SeriesResource).GetTags,
+			types: map[string]exportType[sonarr.SeriesResource]{
+				"": {resource: NewSeriesResource, write: exportWrite[sonarr.SeriesResource, Series]()},
+			},
+		},
+		exportKind[sonarr.ImportListExclusionResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.ImportListExclusionResource, *http.Response, error) {
+				return c.ImportListExclusionAPI.ListImportListExclusion(ctx).Execute()
+			},
+			id:    (*sonarr.ImportListExclusionResource).GetId,
+			label: (*sonarr.ImportListExclusionResource).GetTitle,
+			types: map[string]exportType[sonarr.ImportListExclusionResource]{
+				"": {resource: NewImportListExclusionResource, write: exportPlainWrite[sonarr.ImportListExclusionResource, ImportListExclusion]()},
+			},
+		},
+		exportKind[sonarr.DownloadClientResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.DownloadClientResource, *http.Response, error) {
+				return c.DownloadClientAPI.ListDownloadClient(ctx).Execute()
+			},
+			id:             (*sonarr.DownloadClientResource).GetId,
+			label:          (*sonarr.DownloadClientResource).GetName,
+			implementation: (*sonarr.DownloadClientResource).GetImplementation,
+			tags:           (*sonarr.DownloadClientResource).GetTags,
+			types: map[string]exportType[sonarr.DownloadClientResource]{
+				"":                                                 {resource: NewDownloadClientResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClient]()},
+				downloadClientAria2Implementation:                  {resource: NewDownloadClientAria2Resource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientAria2]()},
+				downloadClientDelugeImplementation:                 {resource: NewDownloadClientDelugeResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientDeluge]()},
+				downloadClientFloodImplementation:                  {resource: NewDownloadClientFloodResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientFlood]()},
+				downloadClientHadoukenImplementation:               {resource: NewDownloadClientHadoukenResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientHadouken]()},
+				downloadClientNzbgetImplementation:                 {resource: NewDownloadClientNzbgetResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientNzbget]()},
+				downloadClientNzbvortexImplementation:              {resource: NewDownloadClientNzbvortexResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientNzbvortex]()},
+				downloadClientPneumaticImplementation:              {resource: NewDownloadClientPneumaticResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientPneumatic]()},
+				downloadClientQbittorrentImplementation:            {resource: NewDownloadClientQbittorrentResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientQbittorrent]()},
+				downloadClientRtorrentImplementation:               {resource: NewDownloadClientRtorrentResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientRtorrent]()},
+				downloadClientSabnzbdImplementation:                {resource: NewDownloadClientSabnzbdResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientSabnzbd]()},
+				downloadClientTorrentBlackholeImplementation:       {resource: NewDownloadClientTorrentBlackholeResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientTorrentBlackhole]()},
+				downloadClientTorrentDownloadStationImplementation: {resource: NewDownloadClientTorrentDownloadStationResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientTorrentDownloadStation]()},
+				downloadClientTransmissionImplementation:           {resource: NewDownloadClientTransmissionResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientTransmission]()},
+				downloadClientUsenetBlackholeImplementation:        {resource: NewDownloadClientUsenetBlackholeResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientUsenetBlackhole]()},
+				downloadClientUsenetDownloadStationImplementation:  {resource: NewDownloadClientUsenetDownloadStationResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientUsenetDownloadStation]()},
+				downloadClientUtorrentImplementation:               {resource: NewDownloadClientUtorrentResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientUtorrent]()},
+				downloadClientVuzeImplementation:                   {resource: NewDownloadClientVuzeResource, write: exportWrite[sonarr.DownloadClientResource, DownloadClientVuze]()},
+			},
+		},
+		exportKind[sonarr.ImportListResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.ImportListResource, *http.Response, error) {
+				return c.ImportListAPI.ListImportList(ctx).Execute()
+			},
+			id:             (*sonarr.ImportListResource).GetId,
+			label:          (*sonarr.ImportListResource).GetName,
+			implementation: (*sonarr.ImportListResource).GetImplementation,
+			tags:           (*sonarr.ImportListResource).GetTags,
+			types: map[string]exportType[sonarr.ImportListResource]{
+				"":                                   {resource: NewImportListResource, write: exportWrite[sonarr.ImportListResource, ImportList]()},
+				importListCustomImplementation:       {resource: NewImportListCustomResource, write: exportWrite[sonarr.ImportListResource, ImportListCustom]()},
+				importListImdbImplementation:         {resource: NewImportListImdbResource, write: exportWrite[sonarr.ImportListResource, ImportListImdb]()},
+				importListPlexImplementation:         {resource: NewImportListPlexResource, write: exportWrite[sonarr.ImportListResource, ImportListPlex]()},
+				importListPlexRSSImplementation:      {resource: NewImportListPlexRSSResource, write: exportWrite[sonarr.ImportListResource, ImportListPlexRSS]()},
+				importListSimklUserImplementation:    {resource: NewImportListSimklUserResource, write: exportWrite[sonarr.ImportListResource, ImportListSimklUser]()},
+				importListSonarrImplementation:       {resource: NewImportListSonarrResource, write: exportWrite[sonarr.ImportListResource, ImportListSonarr]()},
+				importListTraktListImplementation:    {resource: NewImportListTraktListResource, write: exportWrite[sonarr.ImportListResource, ImportListTraktList]()},
+				importListTraktPopularImplementation: {resource: NewImportListTraktPopularResource, write: exportWrite[sonarr.ImportListResource, ImportListTraktPopular]()},
+				importListTraktUserImplementation:    {resource: NewImportListTraktUserResource, write: exportWrite[sonarr.ImportListResource, ImportListTraktUser]()},
+			},
+		},
+		exportKind[sonarr.IndexerResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.IndexerResource, *http.Response, error) {
+				return c.IndexerAPI.ListIndexer(ctx).Execute()
+			},
+			id:             (*sonarr.IndexerResource).GetId,
+			label:          (*sonarr.IndexerResource).GetName,
+			implementation: (*sonarr.IndexerResource).GetImplementation,
+			tags:           (*sonarr.IndexerResource).GetTags,
+			types: map[string]exportType[sonarr.IndexerResource]{
+				"":                                  {resource: NewIndexerResource, write: exportWrite[sonarr.IndexerResource, Indexer]()},
+				indexerBroadcastheNetImplementation: {resource: NewIndexerBroadcastheNetResource, write: exportWrite[sonarr.IndexerResource, IndexerBroadcastheNet]()},
+				indexerFanzubImplementation:         {resource: NewIndexerFanzubResource, write: exportWrite[sonarr.IndexerResource, IndexerFanzub]()},
+				indexerFilelistImplementation:       {resource: NewIndexerFilelistResource, write: exportWrite[sonarr.IndexerResource, IndexerFilelist]()},
+				indexerHdbitsImplementation:         {resource: NewIndexerHdbitsResource, write: exportWrite[sonarr.IndexerResource, IndexerHdbits]()},
+				indexerIptorrentsImplementation:     {resource: NewIndexerIptorrentsResource, write: exportWrite[sonarr.IndexerResource, IndexerIptorrents]()},
+				indexerNewznabImplementation:        {resource: NewIndexerNewznabResource, write: exportWrite[sonarr.IndexerResource, IndexerNewznab]()},
+				indexerNyaaImplementation:           {resource: NewIndexerNyaaResource, write: exportWrite[sonarr.IndexerResource, IndexerNyaa]()},
+				indexerTorrentRssImplementation:     {resource: NewIndexerTorrentRssResource, write: exportWrite[sonarr.IndexerResource, IndexerTorrentRss]()},
+				indexerTorrentleechImplementation:   {resource: NewIndexerTorrentleechResource, write: exportWrite[sonarr.IndexerResource, IndexerTorrentleech]()},
+				indexerTorznabImplementation:        {resource: NewIndexerTorznabResource, write: exportWrite[sonarr.IndexerResource, IndexerTorznab]()},
+			},
+		},
+		exportKind[sonarr.MetadataResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.MetadataResource, *http.Response, error) {
+				return c.MetadataAPI.ListMetadata(ctx).Execute()
+			},
+			id:             (*sonarr.MetadataResource).GetId,
+			label:          (*sonarr.MetadataResource).GetName,
+			implementation: (*sonarr.MetadataResource).GetImplementation,
+			tags:           (*sonarr.MetadataResource).GetTags,
+			types: map[string]exportType[sonarr.MetadataResource]{
+				"":                            {resource: NewMetadataResource, write: exportWrite[sonarr.MetadataResource, Metadata]()},
+				metadataKodiImplementation:    {resource: NewMetadataKodiResource, write: exportWrite[sonarr.MetadataResource, MetadataKodi]()},
+				metadataRoksboxImplementation: {resource: NewMetadataRoksboxResource, write: exportWrite[sonarr.MetadataResource, MetadataRoksbox]()},
+				metadataWdtvImplementation:    {resource: NewMetadataWdtvResource, write: exportWrite[sonarr.MetadataResource, MetadataWdtv]()},
+			},
+		},
+		exportKind[sonarr.NotificationResource]{
+			list: func(ctx context.Context, c *sonarr.APIClient) ([]sonarr.NotificationResource, *http.Response, error) {
+				return c.NotificationAPI.ListNotification(ctx).Execute()
+			},
+			id:             (*sonarr.NotificationResource).GetId,
+			label:          (*sonarr.NotificationResource).GetName,
+			implementation: (*sonarr.NotificationResource).GetImplementation,
+			tags:           (*sonarr.NotificationResource).GetTags,
+			types: map[string]exportType[sonarr.NotificationResource]{
+				"":                                     {resource: NewNotificationResource, write: exportWrite[sonarr.NotificationResource, Notification]()},
+				notificationAppriseImplementation:      {resource: NewNotificationAppriseResource, write: exportWrite[sonarr.NotificationResource, NotificationApprise]()},
+				notificationCustomScriptImplementation: {resource: NewNotificationCustomScriptResource, write: exportWrite[sonarr.NotificationResource, NotificationCustomScript]()},
+				notificationDiscordImplementation:      {resource: NewNotificationDiscordResource, write: exportWrite[sonarr.NotificationResource, NotificationDiscord]()},
+				notificationEmailImplementation:        {resource: NewNotificationEmailResource, write: exportWrite[sonarr.NotificationResource, NotificationEmail]()},
+				notificationEmbyImplementation:         {resource: NewNotificationEmbyResource, write: exportWrite[sonarr.NotificationResource, NotificationEmby]()},
+				notificationGotifyImplementation:       {resource: NewNotificationGotifyResource, write: exportWrite[sonarr.NotificationResource, NotificationGotify]()},
+				notificationJoinImplementation:         {resource: NewNotificationJoinResource, write: exportWrite[sonarr.NotificationResource, NotificationJoin]()},
+				notificationKodiImplementation:         {resource: NewNotificationKodiResource, write: exportWrite[sonarr.NotificationResource, NotificationKodi]()},
+				notificationMailgunImplementation:      {resource: NewNotificationMailgunResource, write: exportWrite[sonarr.NotificationResource, NotificationMailgun]()},
+				notificationNtfyImplementation:         {resource: NewNotificationNtfyResource, write: exportWrite[sonarr.NotificationResource, NotificationNtfy]()},
+				notificationPlexImplementation:         {resource: NewNotificationPlexResource, write: exportWrite[sonarr.NotificationResource, NotificationPlex]()},
+				notificationProwlImplementation:        {resource: NewNotificationProwlResource, write: exportWrite[sonarr.NotificationResource, NotificationProwl]()},
+				notificationPushbulletImplementation:   {resource: NewNotificationPushbulletResource, write: exportWrite[sonarr.NotificationResource, NotificationPushbullet]()},
+				notificationPushoverImplementation:     {resource: NewNotificationPushoverResource, write: exportWrite[sonarr.NotificationResource, NotificationPushover]()},
+				notificationSendgridImplementation:     {resource: NewNotificationSendgridResource, write: exportWrite[sonarr.NotificationResource, NotificationSendgrid]()},
+				notificationSignalImplementation:       {resource: NewNotificationSignalResource, write: exportWrite[sonarr.NotificationResource, NotificationSignal]()},
+				notificationSimplepushImplementation:   {resource: NewNotificationSimplepushResource, write: exportWrite[sonarr.NotificationResource, NotificationSimplepush]()},
+				notificationSlackImplementation:        {resource: NewNotificationSlackResource, write: exportWrite[sonarr.NotificationResource, NotificationSlack]()},
+				notificationSynologyImplementation:     {resource: NewNotificationSynologyResource, write: exportWrite[sonarr.NotificationResource, NotificationSynology]()},
+				notificationTelegramImplementation:     {resource: NewNotificationTelegramResource, write: exportWrite[sonarr.NotificationResource, NotificationTelegram]()},
+				notificationTraktImplementation:        {resource: NewNotificationTraktResource, write: exportWrite[sonarr.NotificationResource, NotificationTrakt]()},
+				notificationTwitterImplementation:      {resource: NewNotificationTwitterResource, write: exportWrite[sonarr.NotificationResource, NotificationTwitter]()},
+				notificationWebhookImplementation:      {resource: NewNotificationWebhookResource, write: exportWrite[sonarr.NotificationResource, NotificationWebhook]()},
+			},
+		},
+		exportKind[sonarr.HostConfigResource]{
+			list: exportSingleton(func(c *sonarr.APIClient, ctx context.Context) (*sonarr.HostConfigResource, *http.Response, error) {
+				return c.HostConfigAPI.GetHostConfig(ctx).Execute()
+			}),
+			id: (*sonarr.HostConfigResource).GetId,
+			types: map[string]exportType[sonarr.HostConfigResource]{
+				"": {resource: NewHostResource, write: exportWrite[sonarr.HostConfigResource, Host]()},
+			},
+		},
+		exportKind[sonarr.NamingConfigResource]{
+			list: exportSingleton(func(c *sonarr.APIClient, ctx context.Context) (*sonarr.NamingConfigResource, *http.Response, error) {
+				return c.NamingConfigAPI.GetNamingConfig(ctx).Execute()
+			}),
+			id: (*sonarr.NamingConfigResource).GetId,
+			types: map[string]exportType[sonarr.NamingConfigResource]{
+				"": {resource: NewNamingResource, write: exportPlainWrite[sonarr.NamingConfigResource, Naming]()},
+			},
+		},
+		exportKind[sonarr.MediaManagementConfigResource]{
+			list: exportSingleton(func(c *sonarr.APIClient, ctx context.Context) (*sonarr.MediaManagementConfigResource, *http.Response, error) {
+				return c.MediaManagementConfigAPI.GetMediaManagementConfig(ctx).Execute()
+			}),
+			id: (*sonarr.MediaManagementConfigResource).GetId,
+			types: map[string]exportType[sonarr.MediaManagementConfigResource]{
+				"": {resource: NewMediaManagementResource, write: exportPlainWrite[sonarr.MediaManagementConfigResource, MediaManagement]()},
+			},
+		},
+		exportKind[sonarr.DownloadClientConfigResource]{
+			list: exportSingleton(func(c *sonarr.APIClient, ctx context.Context) (*sonarr.DownloadClientConfigResource, *http.Response, error) {
+				return c.DownloadClientConfigAPI.GetDownloadClientConfig(ctx).Execute()
+			}),
+			id: (*sonarr.DownloadClientConfigResource).GetId,
+			types: map[string]exportType[sonarr.DownloadClientConfigResource]{
+				"": {resource: NewDownloadClientConfigResource, write: exportPlainWrite[sonarr.DownloadClientConfigResource, DownloadClientConfig]()},
+			},
+		},
+		exportKind[sonarr.IndexerConfigResource]{
+			list: exportSingleton(func(c *sonarr.APIClient, ctx context.Context) (*sonarr.IndexerConfigResource, *http.Response, error) {
+				return c.IndexerConfigAPI.GetIndexerConfig(ctx).Execute()
+			}),
+			id: (*sonarr.IndexerConfigResource).GetId,
+			types: map[string]exportType[sonarr.IndexerConfigResource]{
+				"": {resource: NewIndexerConfigResource, write: exportPlainWrite[sonarr.IndexerConfigResource, IndexerConfig]()},
+			},
+		},
+	}
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/sonarrtest"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	server := sonarrtest.NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+	field := func(name string, value interface{}) sonarr.Field {
+		f := sonarr.NewField()
+		f.SetName(name)
+		f.SetValue(value)
+
+		return *f
+	}
+
+	tag := sonarr.NewTagResource()
+	tag.SetLabel("4K")
+
+	_, _, err := client.TagAPI.CreateTag(ctx).TagResource(*tag).Execute()
+	assert.NoError(t, err)
+
+	downloadClient := sonarr.NewDownloadClientResource()
+	downloadClient.SetName("My Transmission")
+	downloadClient.SetImplementation(downloadClientTransmissionImplementation)
+	downloadClient.SetConfigContract(downloadClientTransmissionConfigContract)
+	downloadClient.SetProtocol(downloadClientTransmissionProtocol)
+	downloadClient.SetFields([]sonarr.Field{field("host", "transmission"), field("port", 9091), field("password", "secret")})
+
+	_, _, err = client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*downloadClient).Execute()
+	assert.NoError(t, err)
+
+	// Implementations without typed resource use the generic one
+	downloadClient.SetName("Other")
+	downloadClient.SetImplementation("Other")
+
+	_, _, err = client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*downloadClient).Execute()
+	assert.NoError(t, err)
+
+	files, diags := Export(ctx, client, ExportFilter{})
+	assert.False(t, diags.HasError())
+
+	for name, content := range files {
+		_, parseDiags := hclsyntax.ParseConfig(content, name, hcl.InitialPos)
+		assert.False(t, parseDiags.HasErrors(), name)
+	}
+
+	assert.Contains(t, string(files["sonarr_tag.tf"]), `resource "sonarr_tag" "tag_4k" {
+  label = "4K"
+}`)
+	assert.Contains(t, string(files["sonarr_tag.tf"]), `import {
+  to = sonarr_tag.tag_4k
+  id = "1"
+}`)
+
+	transmission := string(files["sonarr_download_client_transmission.tf"])
+	assert.Contains(t, transmission, `resource "sonarr_download_client_transmission" "my_transmission" {`)
+	assert.Contains(t, transmission, `  host = "transmission"`)
+	assert.Contains(t, transmission, `  password                   = "`+helpers.SensitiveValue+`"`)
+	assert.Contains(t, string(files["sonarr_download_client.tf"]), `resource "sonarr_download_client" "other" {`)
+
+	// Configuration singletons
+	assert.Contains(t, string(files["sonarr_host.tf"]), `resource "sonarr_host" "host_1" {`)
+}
+
+func TestExportFilter(t *testing.T) {
+	t.Parallel()
+
+	server := sonarrtest.NewServer()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := server.Client()
+
+	tag := sonarr.NewTagResource()
+	tag.SetLabel("4K")
+
+	_, _, err := client.TagAPI.CreateTag(ctx).TagResource(*tag).Execute()
+	assert.NoError(t, err)
+
+	for _, implementation := range []string{downloadClientTransmissionImplementation, downloadClientDelugeImplementation} {
+		downloadClient := sonarr.NewDownloadClientResource()
+		downloadClient.SetName("My " + implementation)
+		downloadClient.SetImplementation(implementation)
+		downloadClient.SetConfigContract(implementation + "Settings")
+		downloadClient.SetProtocol(downloadClientTransmissionProtocol)
+
+		if implementation == downloadClientDelugeImplementation {
+			downloadClient.SetTags([]int32{1})
+		}
+
+		_, _, err = client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*downloadClient).Execute()
+		assert.NoError(t, err)
+	}
+
+	tests := map[string]struct {
+		filter   ExportFilter
+		expected []string
+		err      bool
+	}{
+		"type": {
+			filter:   ExportFilter{Types: []string{"sonarr_tag"}},
+			expected: []string{"sonarr_tag.tf"},
+		},
+		"implementation": {
+			filter:   ExportFilter{Implementation: "transmission"},
+			expected: []string{"sonarr_download_client_transmission.tf"},
+		},
+		"tag": {
+			filter:   ExportFilter{Tag: "4k"},
+			expected: []string{"sonarr_download_client_deluge.tf"},
+		},
+		"name": {
+			filter:   ExportFilter{Name: regexp.MustCompile("^My ")},
+			expected: []string{"sonarr_download_client_deluge.tf", "sonarr_download_client_transmission.tf"},
+		},
+		"missing tag": {
+			filter: ExportFilter{Tag: "missing"},
+			err:    true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			files, diags := Export(ctx, client, test.filter)
+			assert.Equal(t, test.err, diags.HasError())
+
+			names := make([]string, 0, len(files))
+			for name := range files {
+				names = append(names, name)
+			}
+
+			assert.ElementsMatch(t, test.expected, names)
+		})
+	}
+}
@@ -243,7 +243,7 @@ func (d *HostDataSource) Read(ctx context.Context, _ datasource.ReadRequest, res
 	resp.Diagnostics.Append(tempDiag...)
 
 	// Get host current value
-	response, _, err := d.client.HostConfigAPI.GetHostConfig(d.auth).Execute()
+	response, _, err := d.client.HostConfigAPI.GetHostConfig(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, hostDataSourceName, err))
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -28,6 +29,7 @@ const hostResourceName = "host"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &HostResource{}
+	_ resource.ResourceWithModifyPlan  = &HostResource{}
 	_ resource.ResourceWithImportState = &HostResource{}
 )
 
@@ -37,8 +39,9 @@ func NewHostResource() resource.Resource {
 
 // HostResource defines the host implementation.
 type HostResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // Host describes the host data model.
@@ -58,6 +61,12 @@ type Host struct {
 	LaunchBrowser  types.Bool   `tfsdk:"launch_browser"`
 }
 
+// HostResourceModel describes the host resource data model, including timeouts.
+type HostResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Host
+}
+
 // ProxyConfig is part of Host.
 type ProxyConfig struct {
 	Username             types.String `tfsdk:"username"`
@@ -180,7 +189,7 @@ func (r *HostResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_" + hostResourceName
 }
 
-func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *HostResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:System -->\nHost resource.\nFor more information refer to [Host](https://wiki.servarr.com/sonarr/settings#general) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -399,6 +408,13 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -406,12 +422,17 @@ func (r *HostResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *HostResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, hostResourceName, req.State, resp.Plan)...)
+}
+
 func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var host *Host
+	var host *HostResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &host)...)
 
@@ -419,14 +440,17 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, host.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Create resource
 	request := host.read(ctx, &resp.Diagnostics)
 	request.SetId(1)
 
 	// Create new Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(ctx, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, hostResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, hostResourceName, err)
 
 		return
 	}
@@ -439,7 +463,7 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var host *Host
+	var host *HostResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &host)...)
 
@@ -447,8 +471,11 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, host.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get host current value
-	response, _, err := r.client.HostConfigAPI.GetHostConfig(r.auth).Execute()
+	response, _, err := r.client.HostConfigAPI.GetHostConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, hostResourceName, err))
 
@@ -463,7 +490,7 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var host *Host
+	var host *HostResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &host)...)
 
@@ -471,13 +498,23 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, host.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
 	request := host.read(ctx, &resp.Diagnostics)
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.HostConfigAPI.GetHostConfig(ctx).Execute, nil, hostResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(ctx, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, hostResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, hostResourceName, err)
 
 		return
 	}
@@ -519,7 +556,7 @@ func (h *Host) write(ctx context.Context, host *sonarr.HostConfigResource, diags
 	log := LoggingConfig{}
 
 	// Get the state/plan password to propagate the same
-	diags.Append(h.AuthConfig.As(ctx, &auth, basetypes.ObjectAsOptions{})...)
+	diags.Append(h.AuthConfig.As(ctx, &auth, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})...)
 
 	proxy.write(host)
 	ssl.write(host)
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importKey is a natural key accepted as import identifier, e.g. `name:<name>`.
+type importKey[T any] struct {
+	value  func(*T) string
+	format string
+}
+
+// importer resolves import identifiers, either a Sonarr ID or a natural key, using the list endpoint.
+// When implementation is set, the imported object must be of that implementation.
+type importer[T any] struct {
+	list             func(context.Context) ([]T, *http.Response, error)
+	id               func(*T) int32
+	implementationOf func(*T) string
+	implementation   string
+	keys             []importKey[T]
+}
+
+// importState sets the `id` attribute from the import identifier.
+func (i importer[T]) importState(ctx, auth context.Context, resourceName string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, diags := i.resolve(requestContext(ctx, auth), auth != nil, resourceName, req.ID)
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// resolve returns the ID of the object matching the import identifier.
+// Plain IDs of generic resources are passed through without any request.
+func (i importer[T]) resolve(ctx context.Context, configured bool, resourceName, importID string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	id, err := strconv.ParseInt(importID, 10, 64)
+	if err == nil && i.implementation == "" {
+		return id, diags
+	}
+
+	var key *importKey[T]
+
+	prefix, value, _ := strings.Cut(importID, ":")
+	formats := []string{"ID"}
+
+	for k := range i.keys {
+		formats = append(formats, i.keys[k].format)
+		if strings.HasPrefix(i.keys[k].format, prefix+":") {
+			key = &i.keys[k]
+		}
+	}
+
+	if err != nil && key == nil {
+		diags.AddError(
+			helpers.UnexpectedImportIdentifier,
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %s", strings.Join(formats, ", "), importID),
+		)
+
+		return 0, diags
+	}
+
+	if !configured {
+		diags.AddError(helpers.UnexpectedImportIdentifier, "The provider must be configured to import "+resourceName+" with "+importID)
+
+		return 0, diags
+	}
+
+	objects, _, listErr := i.list(ctx)
+	if listErr != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, resourceName, listErr))
+
+		return 0, diags
+	}
+
+	var matches []*T
+
+	for o := range objects {
+		if (key == nil && int64(i.id(&objects[o])) == id) || (key != nil && key.value(&objects[o]) == value) {
+			matches = append(matches, &objects[o])
+		}
+	}
+
+	switch {
+	case len(matches) == 0:
+		diags.AddError(helpers.UnexpectedImportIdentifier, fmt.Sprintf("No %s found for import identifier %s", resourceName, importID))
+	case len(matches) > 1:
+		diags.AddError(helpers.UnexpectedImportIdentifier, fmt.Sprintf("%d objects found for import identifier %s, import %s by ID instead", len(matches), importID, resourceName))
+	case i.implementation != "" && i.implementationOf(matches[0]) != i.implementation:
+		diags.AddError(
+			helpers.UnexpectedImportIdentifier,
+			fmt.Sprintf("Expected %s implementation for %s, got: %s", i.implementation, resourceName, i.implementationOf(matches[0])),
+		)
+	default:
+		return int64(i.id(matches[0])), diags
+	}
+
+	return 0, diags
+}
+
+// nameKey matches objects by name.
+func nameKey[T any](name func(*T) string) []importKey[T] {
+	return []importKey[T]{{value: name, format: "name:<name>"}}
+}
+
+// downloadClientImporter resolves download clients by name.
+func downloadClientImporter(client *sonarr.APIClient, implementation string) importer[sonarr.DownloadClientResource] {
+	return importer[sonarr.DownloadClientResource]{
+		list: func(ctx context.Context) ([]sonarr.DownloadClientResource, *http.Response, error) {
+			return client.DownloadClientAPI.ListDownloadClient(ctx).Execute()
+		},
+		id:               (*sonarr.DownloadClientResource).GetId,
+		implementationOf: (*sonarr.DownloadClientResource).GetImplementation,
+		implementation:   implementation,
+		keys:             nameKey((*sonarr.DownloadClientResource).GetName),
+	}
+}
+
+// importListImporter resolves import lists by name.
+func importListImporter(client *sonarr.APIClient, implementation string) importer[sonarr.ImportListResource] {
+	return importer[sonarr.ImportListResource]{
+		list: func(ctx context.Context) ([]sonarr.ImportListResource, *http.Response, error) {
+			return client.ImportListAPI.ListImportList(ctx).Execute()
+		},
+		id:               (*sonarr.ImportListResource).GetId,
+		implementationOf: (*sonarr.ImportListResource).GetImplementation,
+		implementation:   implementation,
+		keys:             nameKey((*sonarr.ImportListResource).GetName),
+	}
+}
+
+// indexerImporter resolves indexers by name.
+func indexerImporter(client *sonarr.APIClient, implementation string) importer[sonarr.IndexerResource] {
+	return importer[sonarr.IndexerResource]{
+		list: func(ctx context.Context) ([]sonarr.IndexerResource, *http.Response, error) {
+			return client.IndexerAPI.ListIndexer(ctx).Execute()
+		},
+		id:               (*sonarr.IndexerResource).GetId,
+		implementationOf: (*sonarr.IndexerResource).GetImplementation,
+		implementation:   implementation,
+		keys:             nameKey((*sonarr.IndexerResource).GetName),
+	}
+}
+
+// metadataImporter resolves metadata consumers by name.
+func metadataImporter(client *sonarr.APIClient, implementation string) importer[sonarr.MetadataResource] {
+	return importer[sonarr.MetadataResource]{
+		list: func(ctx context.Context) ([]sonarr.MetadataResource, *http.Response, error) {
+			return client.MetadataAPI.ListMetadata(ctx).Execute()
+		},
+		id:               (*sonarr.MetadataResource).GetId,
+		implementationOf: (*sonarr.MetadataResource).GetImplementation,
+		implementation:   implementation,
+		keys:             nameKey((*sonarr.MetadataResource).GetName),
+	}
+}
+
+// notificationImporter resolves notifications by name.
+func notificationImporter(client *sonarr.APIClient, implementation string) importer[sonarr.NotificationResource] {
+	return importer[sonarr.NotificationResource]{
+		list: func(ctx context.Context) ([]sonarr.NotificationResource, *http.Response, error) {
+			return client.NotificationAPI.ListNotification(ctx).Execute()
+		},
+		id:               (*sonarr.NotificationResource).GetId,
+		implementationOf: (*sonarr.NotificationResource).GetImplementation,
+		implementation:   implementation,
+		keys:             nameKey((*sonarr.NotificationResource).GetName),
+	}
+}
+
+// autoTagImporter resolves auto tags by name.
+func autoTagImporter(client *sonarr.APIClient) importer[sonarr.AutoTaggingResource] {
+	return importer[sonarr.AutoTaggingResource]{
+		list: func(ctx context.Context) ([]sonarr.AutoTaggingResource, *http.Response, error) {
+			return client.AutoTaggingAPI.ListAutoTagging(ctx).Execute()
+		},
+		id:   (*sonarr.AutoTaggingResource).GetId,
+		keys: nameKey((*sonarr.AutoTaggingResource).GetName),
+	}
+}
+
+// customFormatImporter resolves custom formats by name.
+func customFormatImporter(client *sonarr.APIClient) importer[sonarr.CustomFormatResource] {
+	return importer[sonarr.CustomFormatResource]{
+		list: func(ctx context.Context) ([]sonarr.CustomFormatResource, *http.Response, error) {
+			return client.CustomFormatAPI.ListCustomFormat(ctx).Execute()
+		},
+		id:   (*sonarr.CustomFormatResource).GetId,
+		keys: nameKey((*sonarr.CustomFormatResource).GetName),
+	}
+}
+
+// qualityProfileImporter resolves quality profiles by name.
+func qualityProfileImporter(client *sonarr.APIClient) importer[sonarr.QualityProfileResource] {
+	return importer[sonarr.QualityProfileResource]{
+		list: func(ctx context.Context) ([]sonarr.QualityProfileResource, *http.Response, error) {
+			return client.QualityProfileAPI.ListQualityProfile(ctx).Execute()
+		},
+		id:   (*sonarr.QualityProfileResource).GetId,
+		keys: nameKey((*sonarr.QualityProfileResource).GetName),
+	}
+}
+
+// releaseProfileImporter resolves release profiles by name.
+func releaseProfileImporter(client *sonarr.APIClient) importer[sonarr.ReleaseProfileResource] {
+	return importer[sonarr.ReleaseProfileResource]{
+		list: func(ctx context.Context) ([]sonarr.ReleaseProfileResource, *http.Response, error) {
+			return client.ReleaseProfileAPI.ListReleaseProfile(ctx).Execute()
+		},
+		id:   (*sonarr.ReleaseProfileResource).GetId,
+		keys: nameKey((*sonarr.ReleaseProfileResource).GetName),
+	}
+}
+
+// tagImporter resolves tags by label.
+func tagImporter(client *sonarr.APIClient) importer[sonarr.TagResource] {
+	return importer[sonarr.TagResource]{
+		list: func(ctx context.Context) ([]sonarr.TagResource, *http.Response, error) {
+			return client.TagAPI.ListTag(ctx).Execute()
+		},
+		id:   (*sonarr.TagResource).GetId,
+		keys: []importKey[sonarr.TagResource]{{value: (*sonarr.TagResource).GetLabel, format: "label:<label>"}},
+	}
+}
+
+// seriesImporter resolves series by TVDB ID.
+func seriesImporter(client *sonarr.APIClient) importer[sonarr.SeriesResource] {
+	return importer[sonarr.SeriesResource]{
+		list: func(ctx context.Context) ([]sonarr.SeriesResource, *http.Response, error) {
+			return client.SeriesAPI.ListSeries(ctx).Execute()
+		},
+		id: (*sonarr.SeriesResource).GetId,
+		keys: []importKey[sonarr.SeriesResource]{{
+			value:  func(s *sonarr.SeriesResource) string { return strconv.Itoa(int(s.GetTvdbId())) },
+			format: "tvdb:<id>",
+		}},
+	}
+}
+
+// importListExclusionImporter resolves import list exclusions by TVDB ID.
+func importListExclusionImporter(client *sonarr.APIClient) importer[sonarr.ImportListExclusionResource] {
+	return importer[sonarr.ImportListExclusionResource]{
+		list: func(ctx context.Context) ([]sonarr.ImportListExclusionResource, *http.Response, error) {
+			return client.ImportListExclusionAPI.ListImportListExclusion(ctx).Execute()
+		},
+		id: (*sonarr.ImportListExclusionResource).GetId,
+		keys: []importKey[sonarr.ImportListExclusionResource]{{
+			value:  func(e *sonarr.ImportListExclusionResource) string { return strconv.Itoa(int(e.GetTvdbId())) },
+			format: "tvdb:<id>",
+		}},
+	}
+}
+
+// rootFolderImporter resolves root folders by path.
+func rootFolderImporter(client *sonarr.APIClient) importer[sonarr.RootFolderResource] {
+	return importer[sonarr.RootFolderResource]{
+		list: func(ctx context.Context) ([]sonarr.RootFolderResource, *http.Response, error) {
+			return client.RootFolderAPI.ListRootFolder(ctx).Execute()
+		},
+		id:   (*sonarr.RootFolderResource).GetId,
+		keys: []importKey[sonarr.RootFolderResource]{{value: (*sonarr.RootFolderResource).GetPath, format: "path:<path>"}},
+	}
+}
+
+// remotePathMappingImporter resolves remote path mappings by host and remote path.
+func remotePathMappingImporter(client *sonarr.APIClient) importer[sonarr.RemotePathMappingResource] {
+	return importer[sonarr.RemotePathMappingResource]{
+		list: func(ctx context.Context) ([]sonarr.RemotePathMappingResource, *http.Response, error) {
+			return client.RemotePathMappingAPI.ListRemotePathMapping(ctx).Execute()
+		},
+		id: (*sonarr.RemotePathMappingResource).GetId,
+		keys: []importKey[sonarr.RemotePathMappingResource]{{
+			value:  func(m *sonarr.RemotePathMappingResource) string { return m.GetHost() + "|" + m.GetRemotePath() },
+			format: "host:<host>|<remote_path>",
+		}},
+	}
+}
+
+// qualityDefinitionImporter resolves quality definitions by title.
+func qualityDefinitionImporter(client *sonarr.APIClient) importer[sonarr.QualityDefinitionResource] {
+	return importer[sonarr.QualityDefinitionResource]{
+		list: func(ctx context.Context) ([]sonarr.QualityDefinitionResource, *http.Response, error) {
+			return client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
+		},
+		id:   (*sonarr.QualityDefinitionResource).GetId,
+		keys: []importKey[sonarr.QualityDefinitionResource]{{value: (*sonarr.QualityDefinitionResource).GetTitle, format: "title:<title>"}},
+	}
+}
+
+// delayProfileImporter resolves delay profiles by their tag IDs, in ascending order.
+// The default delay profile has no tags and is imported with `tags:`.
+func delayProfileImporter(client *sonarr.APIClient) importer[sonarr.DelayProfileResource] {
+	return importer[sonarr.DelayProfileResource]{
+		list: func(ctx context.Context) ([]sonarr.DelayProfileResource, *http.Response, error) {
+			return client.DelayProfileAPI.ListDelayProfile(ctx).Execute()
+		},
+		id: (*sonarr.DelayProfileResource).GetId,
+		keys: []importKey[sonarr.DelayProfileResource]{{
+			value: func(p *sonarr.DelayProfileResource) string {
+				tags := slices.Clone(p.GetTags())
+				slices.Sort(tags)
+
+				ids := make([]string, len(tags))
+				for i, tag := range tags {
+					ids[i] = strconv.Itoa(int(tag))
+				}
+
+				return strings.Join(ids, ",")
+			},
+			format: "tags:<id>,<id>",
+		}},
+	}
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/sonarrtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImporterResolve(t *testing.T) {
+	t.Parallel()
+
+	server := sonarrtest.NewServer()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := server.Client()
+
+	downloadClient := sonarr.NewDownloadClientResource()
+	downloadClient.SetName("Transmission")
+	downloadClient.SetImplementation(downloadClientTransmissionImplementation)
+
+	_, _, err := client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*downloadClient).Execute()
+	assert.NoError(t, err)
+
+	mapping := sonarr.NewRemotePathMappingResource()
+	mapping.SetHost("transmission")
+	mapping.SetRemotePath("/downloads/")
+	mapping.SetLocalPath("/data/")
+
+	_, _, err = client.RemotePathMappingAPI.CreateRemotePathMapping(ctx).RemotePathMappingResource(*mapping).Execute()
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		importID   string
+		importer   importer[sonarr.DownloadClientResource]
+		expected   int64
+		configured bool
+		err        bool
+	}{
+		"id passthrough": {
+			importer: downloadClientImporter(client, ""),
+			importID: "10",
+			expected: 10,
+		},
+		"typed id": {
+			importer:   downloadClientImporter(client, downloadClientTransmissionImplementation),
+			importID:   "1",
+			configured: true,
+			expected:   1,
+		},
+		"name": {
+			importer:   downloadClientImporter(client, ""),
+			importID:   "name:Transmission",
+			configured: true,
+			expected:   1,
+		},
+		"typed name": {
+			importer:   downloadClientImporter(client, downloadClientTransmissionImplementation),
+			importID:   "name:Transmission",
+			configured: true,
+			expected:   1,
+		},
+		"wrong implementation": {
+			importer:   downloadClientImporter(client, downloadClientDelugeImplementation),
+			importID:   "name:Transmission",
+			configured: true,
+			err:        true,
+		},
+		"not found": {
+			importer:   downloadClientImporter(client, ""),
+			importID:   "name:Deluge",
+			configured: true,
+			err:        true,
+		},
+		"unknown key": {
+			importer:   downloadClientImporter(client, ""),
+			importID:   "label:Transmission",
+			configured: true,
+			err:        true,
+		},
+		"not configured": {
+			importer: downloadClientImporter(client, ""),
+			importID: "name:Transmission",
+			err:      true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			id, diags := test.importer.resolve(ctx, test.configured, downloadClientResourceName, test.importID)
+			assert.Equal(t, test.err, diags.HasError())
+			assert.Equal(t, test.expected, id)
+		})
+	}
+
+	// Keys made of several fields
+	id, diags := remotePathMappingImporter(client).resolve(ctx, true, remotePathMappingResourceName, "host:transmission|/downloads/")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, int64(1), id)
+
+	id, diags = qualityDefinitionImporter(client).resolve(ctx, true, qualityDefinitionResourceName, "title:Bluray-2160p")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, int64(21), id)
+
+	profile := sonarr.NewDelayProfileResource()
+	profile.SetTags([]int32{3, 2})
+
+	_, _, err = client.DelayProfileAPI.CreateDelayProfile(ctx).DelayProfileResource(*profile).Execute()
+	assert.NoError(t, err)
+
+	// The default delay profile has no tags
+	id, diags = delayProfileImporter(client).resolve(ctx, true, delayProfileResourceName, "tags:")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, int64(1), id)
+
+	id, diags = delayProfileImporter(client).resolve(ctx, true, delayProfileResourceName, "tags:2,3")
+	assert.False(t, diags.HasError())
+	assert.Equal(t, int64(2), id)
+}
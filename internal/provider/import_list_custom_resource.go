@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListCustomResource{}
 	_ resource.ResourceWithImportState = &ImportListCustomResource{}
+	_ resource.ResourceWithMoveState   = &ImportListCustomResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListCustomResource{}
 )
 
 func NewImportListCustomResource() resource.Resource {
@@ -36,22 +39,25 @@ func NewImportListCustomResource() resource.Resource {
 
 // ImportListCustomResource defines the import list implementation.
 type ImportListCustomResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // ImportListCustom describes the import list data model.
 type ImportListCustom struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	BaseURL            types.String `tfsdk:"base_url"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	Tags               types.Set      `tfsdk:"tags"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Name               types.String   `tfsdk:"name"`
+	ShouldMonitor      types.String   `tfsdk:"should_monitor"`
+	RootFolderPath     types.String   `tfsdk:"root_folder_path"`
+	SeriesType         types.String   `tfsdk:"series_type"`
+	BaseURL            types.String   `tfsdk:"base_url"`
+	QualityProfileID   types.Int64    `tfsdk:"quality_profile_id"`
+	ID                 types.Int64    `tfsdk:"id"`
+	EnableAutomaticAdd types.Bool     `tfsdk:"enable_automatic_add"`
+	SeasonFolder       types.Bool     `tfsdk:"season_folder"`
 }
 
 func (i ImportListCustom) toImportList() *ImportList {
@@ -88,7 +94,7 @@ func (r *ImportListCustomResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_" + importListCustomResourceName
 }
 
-func (r *ImportListCustomResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListCustomResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList Custom resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [Custom](https://wiki.servarr.com/sonarr/supported#customimport).",
 		Attributes: map[string]schema.Attribute{
@@ -145,6 +151,14 @@ func (r *ImportListCustomResource) Schema(_ context.Context, _ resource.SchemaRe
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -152,9 +166,17 @@ func (r *ImportListCustomResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *ImportListCustomResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListCustomResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListCustomResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListCustom
@@ -165,12 +187,17 @@ func (r *ImportListCustomResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListCustomResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Create, importListCustomResourceName, err)
 
 		return
 	}
@@ -179,6 +206,7 @@ func (r *ImportListCustomResource) Create(ctx context.Context, req resource.Crea
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListCustomResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,9 +219,19 @@ func (r *ImportListCustomResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListCustom current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListCustomResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListCustomResourceName, err))
 
 		return
@@ -203,6 +241,7 @@ func (r *ImportListCustomResource) Read(ctx context.Context, req resource.ReadRe
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListCustomResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -215,12 +254,24 @@ func (r *ImportListCustomResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute, helpers.ClearedFields(importList, importListFields), importListCustomResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListCustomResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Update, importListCustomResourceName, err)
 
 		return
 	}
@@ -229,19 +280,27 @@ func (r *ImportListCustomResource) Update(ctx context.Context, req resource.Upda
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListCustomResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListCustom current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListCustomResourceName, err))
 
@@ -253,10 +312,19 @@ func (r *ImportListCustomResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ImportListCustomResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListImporter(r.client, importListCustomImplementation).importState(ctx, r.auth, importListCustomResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListCustomResourceName+": "+req.ID)
 }
 
+func (r *ImportListCustomResource) MoveState(ctx context.Context) []resource.StateMover {
+	return importListMover(ctx, importListCustomImplementation, func(importList *ImportListResourceModel) interface{} {
+		moved := ImportListCustom{Timeouts: importList.Timeouts}
+		moved.fromImportList(&importList.ImportList)
+
+		return &moved
+	})
+}
+
 func (i *ImportListCustom) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -188,7 +188,7 @@ func (d *ImportListDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 	// Get importList current value
-	response, _, err := d.client.ImportListAPI.ListImportList(d.auth).Execute()
+	response, _, err := d.client.ImportListAPI.ListImportList(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListDataSourceName, err))
 
@@ -68,7 +68,7 @@ func (d *ImportListExclusionDataSource) Read(ctx context.Context, req datasource
 	}
 
 	// Get importListExclusions current value
-	response, _, err := d.client.ImportListExclusionAPI.ListImportListExclusion(d.auth).Execute()
+	response, _, err := d.client.ImportListExclusionAPI.ListImportListExclusion(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionDataSourceName, err))
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,6 +22,7 @@ const importListExclusionResourceName = "import_list_exclusion"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &ImportListExclusionResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListExclusionResource{}
 	_ resource.ResourceWithImportState = &ImportListExclusionResource{}
 )
 
@@ -30,8 +32,9 @@ func NewImportListExclusionResource() resource.Resource {
 
 // ImportListExclusionResource defines the importListExclusion implementation.
 type ImportListExclusionResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // ImportListExclusion describes the importListExclusion data model.
@@ -41,6 +44,12 @@ type ImportListExclusion struct {
 	ID     types.Int64  `tfsdk:"id"`
 }
 
+// ImportListExclusionResourceModel describes the import list exclusion resource data model, including timeouts.
+type ImportListExclusionResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	ImportListExclusion
+}
+
 func (i ImportListExclusion) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -54,7 +63,7 @@ func (r *ImportListExclusionResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_" + importListExclusionResourceName
 }
 
-func (r *ImportListExclusionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListExclusionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImport List Exclusion resource.\nFor more information refer to [ImportListExclusions](https://wiki.servarr.com/sonarr/settings#list-exclusions) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -74,6 +83,14 @@ func (r *ImportListExclusionResource) Schema(_ context.Context, _ resource.Schem
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -81,12 +98,17 @@ func (r *ImportListExclusionResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *ImportListExclusionResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListExclusionResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var importListExclusion *ImportListExclusion
+	var importListExclusion *ImportListExclusionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importListExclusion)...)
 
@@ -94,12 +116,15 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importListExclusion.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportListExclusion
 	request := importListExclusion.read()
 
-	response, _, err := r.client.ImportListExclusionAPI.CreateImportListExclusion(r.auth).ImportListExclusionResource(*request).Execute()
+	response, _, err := r.client.ImportListExclusionAPI.CreateImportListExclusion(ctx).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListExclusionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, importListExclusionResourceName, err)
 
 		return
 	}
@@ -112,7 +137,7 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 
 func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var importListExclusion *ImportListExclusion
+	var importListExclusion *ImportListExclusionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &importListExclusion)...)
 
@@ -120,9 +145,19 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importListExclusion.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get importListExclusion current value
-	response, _, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(r.auth, int32(importListExclusion.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(ctx, int32(importListExclusion.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListExclusionResourceName, importListExclusion.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionResourceName, err))
 
 		return
@@ -136,7 +171,7 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 
 func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var importListExclusion *ImportListExclusion
+	var importListExclusion *ImportListExclusionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importListExclusion)...)
 
@@ -144,12 +179,22 @@ func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importListExclusion.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportListExclusion
 	request := importListExclusion.read()
 
-	response, _, err := r.client.ImportListExclusionAPI.UpdateImportListExclusion(r.auth, strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListExclusionAPI.GetImportListExclusionById(ctx, int32(importListExclusion.ID.ValueInt64())).Execute, nil, importListExclusionResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListExclusionAPI.UpdateImportListExclusion(ctx, strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListExclusionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, importListExclusionResourceName, err)
 
 		return
 	}
@@ -161,16 +206,23 @@ func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *ImportListExclusionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete importListExclusion current value
-	_, err := r.client.ImportListExclusionAPI.DeleteImportListExclusion(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListExclusionAPI.DeleteImportListExclusion(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListExclusionResourceName, err))
 
@@ -182,7 +234,7 @@ func (r *ImportListExclusionResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListExclusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListExclusionImporter(r.client).importState(ctx, r.auth, importListExclusionResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListExclusionResourceName+": "+req.ID)
 }
 
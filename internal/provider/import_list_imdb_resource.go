@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListImdbResource{}
 	_ resource.ResourceWithImportState = &ImportListImdbResource{}
+	_ resource.ResourceWithMoveState   = &ImportListImdbResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListImdbResource{}
 )
 
 func NewImportListImdbResource() resource.Resource {
@@ -36,22 +39,25 @@ func NewImportListImdbResource() resource.Resource {
 
 // ImportListImdbResource defines the import list implementation.
 type ImportListImdbResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // ImportListImdb describes the import list data model.
 type ImportListImdb struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	ListID             types.String `tfsdk:"list_id"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	Tags               types.Set      `tfsdk:"tags"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Name               types.String   `tfsdk:"name"`
+	ShouldMonitor      types.String   `tfsdk:"should_monitor"`
+	RootFolderPath     types.String   `tfsdk:"root_folder_path"`
+	SeriesType         types.String   `tfsdk:"series_type"`
+	ListID             types.String   `tfsdk:"list_id"`
+	QualityProfileID   types.Int64    `tfsdk:"quality_profile_id"`
+	ID                 types.Int64    `tfsdk:"id"`
+	EnableAutomaticAdd types.Bool     `tfsdk:"enable_automatic_add"`
+	SeasonFolder       types.Bool     `tfsdk:"season_folder"`
 }
 
 func (i ImportListImdb) toImportList() *ImportList {
@@ -88,7 +94,7 @@ func (r *ImportListImdbResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_" + importListImdbResourceName
 }
 
-func (r *ImportListImdbResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListImdbResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList Imdb resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [Imdb](https://wiki.servarr.com/sonarr/supported#imdbimport).",
 		Attributes: map[string]schema.Attribute{
@@ -145,6 +151,14 @@ func (r *ImportListImdbResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -152,9 +166,17 @@ func (r *ImportListImdbResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *ImportListImdbResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListImdbResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListImdbResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListImdb
@@ -165,12 +187,17 @@ func (r *ImportListImdbResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportListImdb
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListImdbResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Create, importListImdbResourceName, err)
 
 		return
 	}
@@ -179,6 +206,7 @@ func (r *ImportListImdbResource) Create(ctx context.Context, req resource.Create
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListImdbResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,9 +219,19 @@ func (r *ImportListImdbResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListImdb current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListImdbResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListImdbResourceName, err))
 
 		return
@@ -203,6 +241,7 @@ func (r *ImportListImdbResource) Read(ctx context.Context, req resource.ReadRequ
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListImdbResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -215,12 +254,24 @@ func (r *ImportListImdbResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportListImdb
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute, helpers.ClearedFields(importList, importListFields), importListImdbResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListImdbResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Update, importListImdbResourceName, err)
 
 		return
 	}
@@ -229,19 +280,27 @@ func (r *ImportListImdbResource) Update(ctx context.Context, req resource.Update
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListImdbResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListImdb current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListImdbResourceName, err))
 
@@ -253,10 +312,19 @@ func (r *ImportListImdbResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ImportListImdbResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListImporter(r.client, importListImdbImplementation).importState(ctx, r.auth, importListImdbResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListImdbResourceName+": "+req.ID)
 }
 
+func (r *ImportListImdbResource) MoveState(ctx context.Context) []resource.StateMover {
+	return importListMover(ctx, importListImdbImplementation, func(importList *ImportListResourceModel) interface{} {
+		moved := ImportListImdb{Timeouts: importList.Timeouts}
+		moved.fromImportList(&importList.ImportList)
+
+		return &moved
+	})
+}
+
 func (i *ImportListImdb) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
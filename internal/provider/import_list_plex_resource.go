@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListPlexResource{}
 	_ resource.ResourceWithImportState = &ImportListPlexResource{}
+	_ resource.ResourceWithMoveState   = &ImportListPlexResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListPlexResource{}
 )
 
 func NewImportListPlexResource() resource.Resource {
@@ -36,22 +39,26 @@ func NewImportListPlexResource() resource.Resource {
 
 // ImportListPlexResource defines the import list implementation.
 type ImportListPlexResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // ImportListPlex describes the import list data model.
 type ImportListPlex struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	AccessToken        types.String `tfsdk:"access_token"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	Tags               types.Set      `tfsdk:"tags"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Name               types.String   `tfsdk:"name"`
+	ShouldMonitor      types.String   `tfsdk:"should_monitor"`
+	RootFolderPath     types.String   `tfsdk:"root_folder_path"`
+	SeriesType         types.String   `tfsdk:"series_type"`
+	AccessToken        types.String   `tfsdk:"access_token"`
+	QualityProfileID   types.Int64    `tfsdk:"quality_profile_id"`
+	ID                 types.Int64    `tfsdk:"id"`
+	EnableAutomaticAdd types.Bool     `tfsdk:"enable_automatic_add"`
+	SeasonFolder       types.Bool     `tfsdk:"season_folder"`
 }
 
 func (i ImportListPlex) toImportList() *ImportList {
@@ -88,7 +95,7 @@ func (r *ImportListPlexResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_" + importListPlexResourceName
 }
 
-func (r *ImportListPlexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListPlexResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList Plex resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [Plex](https://wiki.servarr.com/sonarr/supported#pleximport).",
 		Attributes: map[string]schema.Attribute{
@@ -146,6 +153,14 @@ func (r *ImportListPlexResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Sensitive:           true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -153,9 +168,18 @@ func (r *ImportListPlexResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *ImportListPlexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListPlexResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListPlexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListPlex
@@ -166,20 +190,27 @@ func (r *ImportListPlexResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Create, importListPlexResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+importListPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -192,18 +223,33 @@ func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListPlex current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListPlexResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListPlexResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, importList.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, importListSecretTest(ctx, r.client, *response), importListPlexResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+importListPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListPlexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -216,33 +262,54 @@ func (r *ImportListPlexResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute, helpers.ClearedFields(importList, importListFields), importListPlexResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Update, importListPlexResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+importListPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListPlex current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListPlexResourceName, err))
 
@@ -254,10 +321,19 @@ func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ImportListPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListImporter(r.client, importListPlexImplementation).importState(ctx, r.auth, importListPlexResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListPlexResourceName+": "+req.ID)
 }
 
+func (r *ImportListPlexResource) MoveState(ctx context.Context) []resource.StateMover {
+	return importListMover(ctx, importListPlexImplementation, func(importList *ImportListResourceModel) interface{} {
+		moved := ImportListPlex{Timeouts: importList.Timeouts}
+		moved.fromImportList(&importList.ImportList)
+
+		return &moved
+	})
+}
+
 func (i *ImportListPlex) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListPlexRSSResource{}
 	_ resource.ResourceWithImportState = &ImportListPlexRSSResource{}
+	_ resource.ResourceWithMoveState   = &ImportListPlexRSSResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListPlexRSSResource{}
 )
 
 func NewImportListPlexRSSResource() resource.Resource {
@@ -36,22 +39,25 @@ func NewImportListPlexRSSResource() resource.Resource {
 
 // ImportListPlexRSSResource defines the import list implementation.
 type ImportListPlexRSSResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // ImportListPlexRSS describes the import list data model.
 type ImportListPlexRSS struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	URL                types.String `tfsdk:"url"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	Tags               types.Set      `tfsdk:"tags"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Name               types.String   `tfsdk:"name"`
+	ShouldMonitor      types.String   `tfsdk:"should_monitor"`
+	RootFolderPath     types.String   `tfsdk:"root_folder_path"`
+	SeriesType         types.String   `tfsdk:"series_type"`
+	URL                types.String   `tfsdk:"url"`
+	QualityProfileID   types.Int64    `tfsdk:"quality_profile_id"`
+	ID                 types.Int64    `tfsdk:"id"`
+	EnableAutomaticAdd types.Bool     `tfsdk:"enable_automatic_add"`
+	SeasonFolder       types.Bool     `tfsdk:"season_folder"`
 }
 
 func (i ImportListPlexRSS) toImportList() *ImportList {
@@ -88,7 +94,7 @@ func (r *ImportListPlexRSSResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_" + importListPlexRSSResourceName
 }
 
-func (r *ImportListPlexRSSResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListPlexRSSResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList Plex RSS resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [Plex RSS](https://wiki.servarr.com/sonarr/supported#plexrssimport).",
 		Attributes: map[string]schema.Attribute{
@@ -145,6 +151,14 @@ func (r *ImportListPlexRSSResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -152,9 +166,17 @@ func (r *ImportListPlexRSSResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *ImportListPlexRSSResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListPlexRSSResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListPlexRSSResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListPlexRSS
@@ -165,12 +187,17 @@ func (r *ImportListPlexRSSResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportListPlexRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexRSSResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Create, importListPlexRSSResourceName, err)
 
 		return
 	}
@@ -179,6 +206,7 @@ func (r *ImportListPlexRSSResource) Create(ctx context.Context, req resource.Cre
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListPlexRSSResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,9 +219,19 @@ func (r *ImportListPlexRSSResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListPlexRSS current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListPlexRSSResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListPlexRSSResourceName, err))
 
 		return
@@ -203,6 +241,7 @@ func (r *ImportListPlexRSSResource) Read(ctx context.Context, req resource.ReadR
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListPlexRSSResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -215,12 +254,24 @@ func (r *ImportListPlexRSSResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportListPlexRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute, helpers.ClearedFields(importList, importListFields), importListPlexRSSResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexRSSResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Update, importListPlexRSSResourceName, err)
 
 		return
 	}
@@ -229,19 +280,27 @@ func (r *ImportListPlexRSSResource) Update(ctx context.Context, req resource.Upd
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListPlexRSSResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListPlexRSS current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListPlexRSSResourceName, err))
 
@@ -253,10 +312,19 @@ func (r *ImportListPlexRSSResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ImportListPlexRSSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListImporter(r.client, importListPlexRSSImplementation).importState(ctx, r.auth, importListPlexRSSResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListPlexRSSResourceName+": "+req.ID)
 }
 
+func (r *ImportListPlexRSSResource) MoveState(ctx context.Context) []resource.StateMover {
+	return importListMover(ctx, importListPlexRSSImplementation, func(importList *ImportListResourceModel) interface{} {
+		moved := ImportListPlexRSS{Timeouts: importList.Timeouts}
+		moved.fromImportList(&importList.ImportList)
+
+		return &moved
+	})
+}
+
 func (i *ImportListPlexRSS) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
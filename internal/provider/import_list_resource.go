@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +24,7 @@ const importListResourceName = "import_list"
 var (
 	_ resource.Resource                = &ImportListResource{}
 	_ resource.ResourceWithImportState = &ImportListResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListResource{}
 )
 
 var importListFields = helpers.Fields{
@@ -37,8 +39,11 @@ func NewImportListResource() resource.Resource {
 
 // ImportListResource defines the download client implementation.
 type ImportListResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // ImportList describes the download client data model.
@@ -76,6 +81,12 @@ type ImportList struct {
 	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
 }
 
+// ImportListResourceModel describes the import list resource data model, including timeouts.
+type ImportListResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	ImportList
+}
+
 func (i ImportList) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -117,7 +128,7 @@ func (r *ImportListResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_" + importListResourceName
 }
 
-func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nGeneric Import List resource. When possible use a specific resource instead.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists).",
 		Attributes: map[string]schema.Attribute{
@@ -284,6 +295,14 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType:         types.Int64Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -291,12 +310,21 @@ func (r *ImportListResource) Configure(ctx context.Context, req resource.Configu
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *ImportListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var importList *ImportList
+	var importList *ImportListResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -304,28 +332,35 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Create, importListResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	state := ImportListResourceModel{Timeouts: importList.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var importList *ImportList
+	var importList *ImportListResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &importList)...)
 
@@ -333,26 +368,41 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportList current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, importList.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, importListSecretTest(ctx, r.client, *response), importListResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	state := ImportListResourceModel{Timeouts: importList.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var importList *ImportList
+	var importList *ImportListResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
 
@@ -360,36 +410,57 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute, helpers.ClearedFields(importList, importListFields), importListResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Update, importListResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+importListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state ImportList
+	state := ImportListResourceModel{Timeouts: importList.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportList current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListResourceName, err))
 
@@ -401,7 +472,7 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ImportListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListImporter(r.client, "").importState(ctx, r.auth, importListResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListResourceName+": "+req.ID)
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -29,6 +30,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListSimklUserResource{}
 	_ resource.ResourceWithImportState = &ImportListSimklUserResource{}
+	_ resource.ResourceWithMoveState   = &ImportListSimklUserResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListSimklUserResource{}
 )
 
 func NewImportListSimklUserResource() resource.Resource {
@@ -37,26 +40,30 @@ func NewImportListSimklUserResource() resource.Resource {
 
 // ImportListSimklUserResource defines the import list implementation.
 type ImportListSimklUserResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // ImportListSimklUser describes the import list data model.
 type ImportListSimklUser struct {
-	Tags               types.Set    `tfsdk:"tags"`
-	Name               types.String `tfsdk:"name"`
-	ShouldMonitor      types.String `tfsdk:"should_monitor"`
-	RootFolderPath     types.String `tfsdk:"root_folder_path"`
-	SeriesType         types.String `tfsdk:"series_type"`
-	AccessToken        types.String `tfsdk:"access_token"`
-	RefreshToken       types.String `tfsdk:"refresh_token"`
-	Expires            types.String `tfsdk:"expires"`
-	AuthUser           types.String `tfsdk:"auth_user"`
-	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
-	ID                 types.Int64  `tfsdk:"id"`
-	ListType           types.Int64  `tfsdk:"list_type"`
-	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	Tags               types.Set      `tfsdk:"tags"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Name               types.String   `tfsdk:"name"`
+	ShouldMonitor      types.String   `tfsdk:"should_monitor"`
+	RootFolderPath     types.String   `tfsdk:"root_folder_path"`
+	SeriesType         types.String   `tfsdk:"series_type"`
+	AccessToken        types.String   `tfsdk:"access_token"`
+	RefreshToken       types.String   `tfsdk:"refresh_token"`
+	Expires            types.String   `tfsdk:"expires"`
+	AuthUser           types.String   `tfsdk:"auth_user"`
+	QualityProfileID   types.Int64    `tfsdk:"quality_profile_id"`
+	ID                 types.Int64    `tfsdk:"id"`
+	ListType           types.Int64    `tfsdk:"list_type"`
+	EnableAutomaticAdd types.Bool     `tfsdk:"enable_automatic_add"`
+	SeasonFolder       types.Bool     `tfsdk:"season_folder"`
 }
 
 func (i ImportListSimklUser) toImportList() *ImportList {
@@ -101,7 +108,7 @@ func (r *ImportListSimklUserResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_" + importListSimklUserResourceName
 }
 
-func (r *ImportListSimklUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListSimklUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList SimklUser resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [SimklUser](https://wiki.servarr.com/sonarr/supported#simkl_user).",
 		Attributes: map[string]schema.Attribute{
@@ -183,6 +190,14 @@ func (r *ImportListSimklUserResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -190,9 +205,18 @@ func (r *ImportListSimklUserResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *ImportListSimklUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListSimklUserResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListSimklUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListSimklUser
@@ -203,20 +227,27 @@ func (r *ImportListSimklUserResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportListSimklUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListSimklUserResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Create, importListSimklUserResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+importListSimklUserResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListSimklUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -229,18 +260,33 @@ func (r *ImportListSimklUserResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListSimklUser current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListSimklUserResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSimklUserResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, importList.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, importListSecretTest(ctx, r.client, *response), importListSimklUserResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+importListSimklUserResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListSimklUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -253,33 +299,54 @@ func (r *ImportListSimklUserResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportListSimklUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute, helpers.ClearedFields(importList, importListFields), importListSimklUserResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListSimklUserResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Update, importListSimklUserResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+importListSimklUserResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListSimklUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListSimklUser current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListSimklUserResourceName, err))
 
@@ -291,10 +358,19 @@ func (r *ImportListSimklUserResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListSimklUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListImporter(r.client, importListSimklUserImplementation).importState(ctx, r.auth, importListSimklUserResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListSimklUserResourceName+": "+req.ID)
 }
 
+func (r *ImportListSimklUserResource) MoveState(ctx context.Context) []resource.StateMover {
+	return importListMover(ctx, importListSimklUserImplementation, func(importList *ImportListResourceModel) interface{} {
+		moved := ImportListSimklUser{Timeouts: importList.Timeouts}
+		moved.fromImportList(&importList.ImportList)
+
+		return &moved
+	})
+}
+
 func (i *ImportListSimklUser) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
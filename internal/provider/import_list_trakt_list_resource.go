@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListTraktListResource{}
 	_ resource.ResourceWithImportState = &ImportListTraktListResource{}
+	_ resource.ResourceWithMoveState   = &ImportListTraktListResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListTraktListResource{}
 )
 
 func NewImportListTraktListResource() resource.Resource {
@@ -36,29 +39,33 @@ func NewImportListTraktListResource() resource.Resource {
 
 // ImportListTraktListResource defines the import list implementation.
 type ImportListTraktListResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // ImportListTraktList describes the import list data model.
 type ImportListTraktList struct {
-	Tags                      types.Set    `tfsdk:"tags"`
-	Name                      types.String `tfsdk:"name"`
-	ShouldMonitor             types.String `tfsdk:"should_monitor"`
-	RootFolderPath            types.String `tfsdk:"root_folder_path"`
-	SeriesType                types.String `tfsdk:"series_type"`
-	AccessToken               types.String `tfsdk:"access_token"`
-	RefreshToken              types.String `tfsdk:"refresh_token"`
-	Expires                   types.String `tfsdk:"expires"`
-	AuthUser                  types.String `tfsdk:"auth_user"`
-	Username                  types.String `tfsdk:"username"`
-	Listname                  types.String `tfsdk:"listname"`
-	TraktAdditionalParameters types.String `tfsdk:"trakt_additional_parameters"`
-	QualityProfileID          types.Int64  `tfsdk:"quality_profile_id"`
-	ID                        types.Int64  `tfsdk:"id"`
-	Limit                     types.Int64  `tfsdk:"limit"`
-	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	Tags                      types.Set      `tfsdk:"tags"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	Name                      types.String   `tfsdk:"name"`
+	ShouldMonitor             types.String   `tfsdk:"should_monitor"`
+	RootFolderPath            types.String   `tfsdk:"root_folder_path"`
+	SeriesType                types.String   `tfsdk:"series_type"`
+	AccessToken               types.String   `tfsdk:"access_token"`
+	RefreshToken              types.String   `tfsdk:"refresh_token"`
+	Expires                   types.String   `tfsdk:"expires"`
+	AuthUser                  types.String   `tfsdk:"auth_user"`
+	Username                  types.String   `tfsdk:"username"`
+	Listname                  types.String   `tfsdk:"listname"`
+	TraktAdditionalParameters types.String   `tfsdk:"trakt_additional_parameters"`
+	QualityProfileID          types.Int64    `tfsdk:"quality_profile_id"`
+	ID                        types.Int64    `tfsdk:"id"`
+	Limit                     types.Int64    `tfsdk:"limit"`
+	EnableAutomaticAdd        types.Bool     `tfsdk:"enable_automatic_add"`
+	SeasonFolder              types.Bool     `tfsdk:"season_folder"`
 }
 
 func (i ImportListTraktList) toImportList() *ImportList {
@@ -109,7 +116,7 @@ func (r *ImportListTraktListResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_" + importListTraktListResourceName
 }
 
-func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListTraktListResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList TraktList resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [TraktList](https://wiki.servarr.com/sonarr/supported#trakt_list).",
 		Attributes: map[string]schema.Attribute{
@@ -202,6 +209,14 @@ func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -209,9 +224,18 @@ func (r *ImportListTraktListResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *ImportListTraktListResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListTraktListResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListTraktListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListTraktList
@@ -222,20 +246,27 @@ func (r *ImportListTraktListResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktListResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Create, importListTraktListResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+importListTraktListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListTraktListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -248,18 +279,33 @@ func (r *ImportListTraktListResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListTraktList current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListTraktListResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktListResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, importList.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, importListSecretTest(ctx, r.client, *response), importListTraktListResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+importListTraktListResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListTraktListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -272,33 +318,54 @@ func (r *ImportListTraktListResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute, helpers.ClearedFields(importList, importListFields), importListTraktListResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktListResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Update, importListTraktListResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+importListTraktListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListTraktListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListTraktList current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktListResourceName, err))
 
@@ -310,10 +377,19 @@ func (r *ImportListTraktListResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListTraktListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListImporter(r.client, importListTraktListImplementation).importState(ctx, r.auth, importListTraktListResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListTraktListResourceName+": "+req.ID)
 }
 
+func (r *ImportListTraktListResource) MoveState(ctx context.Context) []resource.StateMover {
+	return importListMover(ctx, importListTraktListImplementation, func(importList *ImportListResourceModel) interface{} {
+		moved := ImportListTraktList{Timeouts: importList.Timeouts}
+		moved.fromImportList(&importList.ImportList)
+
+		return &moved
+	})
+}
+
 func (i *ImportListTraktList) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -29,6 +30,8 @@ const (
 var (
 	_ resource.Resource                = &ImportListTraktPopularResource{}
 	_ resource.ResourceWithImportState = &ImportListTraktPopularResource{}
+	_ resource.ResourceWithMoveState   = &ImportListTraktPopularResource{}
+	_ resource.ResourceWithModifyPlan  = &ImportListTraktPopularResource{}
 )
 
 func NewImportListTraktPopularResource() resource.Resource {
@@ -37,31 +40,35 @@ func NewImportListTraktPopularResource() resource.Resource {
 
 // ImportListTraktPopularResource defines the import list implementation.
 type ImportListTraktPopularResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // ImportListTraktPopular describes the import list data model.
 type ImportListTraktPopular struct {
-	Tags                      types.Set    `tfsdk:"tags"`
-	Name                      types.String `tfsdk:"name"`
-	ShouldMonitor             types.String `tfsdk:"should_monitor"`
-	RootFolderPath            types.String `tfsdk:"root_folder_path"`
-	SeriesType                types.String `tfsdk:"series_type"`
-	AccessToken               types.String `tfsdk:"access_token"`
-	RefreshToken              types.String `tfsdk:"refresh_token"`
-	Expires                   types.String `tfsdk:"expires"`
-	AuthUser                  types.String `tfsdk:"auth_user"`
-	Rating                    types.String `tfsdk:"rating"`
-	Genres                    types.String `tfsdk:"genres"`
-	Years                     types.String `tfsdk:"years"`
-	TraktAdditionalParameters types.String `tfsdk:"trakt_additional_parameters"`
-	QualityProfileID          types.Int64  `tfsdk:"quality_profile_id"`
-	ID                        types.Int64  `tfsdk:"id"`
-	Limit                     types.Int64  `tfsdk:"limit"`
-	TraktListType             types.Int64  `tfsdk:"trakt_list_type"`
-	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
-	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	Tags                      types.Set      `tfsdk:"tags"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	Name                      types.String   `tfsdk:"name"`
+	ShouldMonitor             types.String   `tfsdk:"should_monitor"`
+	RootFolderPath            types.String   `tfsdk:"root_folder_path"`
+	SeriesType                types.String   `tfsdk:"series_type"`
+	AccessToken               types.String   `tfsdk:"access_token"`
+	RefreshToken              types.String   `tfsdk:"refresh_token"`
+	Expires                   types.String   `tfsdk:"expires"`
+	AuthUser                  types.String   `tfsdk:"auth_user"`
+	Rating                    types.String   `tfsdk:"rating"`
+	Genres                    types.String   `tfsdk:"genres"`
+	Years                     types.String   `tfsdk:"years"`
+	TraktAdditionalParameters types.String   `tfsdk:"trakt_additional_parameters"`
+	QualityProfileID          types.Int64    `tfsdk:"quality_profile_id"`
+	ID                        types.Int64    `tfsdk:"id"`
+	Limit                     types.Int64    `tfsdk:"limit"`
+	TraktListType             types.Int64    `tfsdk:"trakt_list_type"`
+	EnableAutomaticAdd        types.Bool     `tfsdk:"enable_automatic_add"`
+	SeasonFolder              types.Bool     `tfsdk:"season_folder"`
 }
 
 func (i ImportListTraktPopular) toImportList() *ImportList {
@@ -116,7 +123,7 @@ func (r *ImportListTraktPopularResource) Metadata(_ context.Context, req resourc
 	resp.TypeName = req.ProviderTypeName + "_" + importListTraktPopularResourceName
 }
 
-func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ImportListTraktPopularResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList TraktPopular resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [TraktPopular](https://wiki.servarr.com/sonarr/supported#trakt_popular).",
 		Attributes: map[string]schema.Attribute{
@@ -223,6 +230,14 @@ func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -230,9 +245,18 @@ func (r *ImportListTraktPopularResource) Configure(ctx context.Context, req reso
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *ImportListTraktPopularResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, importListTraktPopularResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var importList *ImportListTraktPopular
@@ -243,20 +267,27 @@ func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(ctx).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktPopularResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Create, importListTraktPopularResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+importListTraktPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -269,18 +300,33 @@ func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get ImportListTraktPopular current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(importListTraktPopularResourceName, importList.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktPopularResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, importList.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, importListSecretTest(ctx, r.client, *response), importListTraktPopularResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+importListTraktPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -293,33 +339,54 @@ func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, importList.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ImportListAPI.GetImportListById(ctx, int32(importList.ID.ValueInt64())).Execute, helpers.ClearedFields(importList, importListFields), importListTraktPopularResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(ctx, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktPopularResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, importListFields, helpers.Update, importListTraktPopularResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+importListTraktPopularResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	importList.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete ImportListTraktPopular current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktPopularResourceName, err))
 
@@ -331,10 +398,19 @@ func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *ImportListTraktPopularResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	importListImporter(r.client, importListTraktPopularImplementation).importState(ctx, r.auth, importListTraktPopularResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+importListTraktPopularResourceName+": "+req.ID)
 }
 
+func (r *ImportListTraktPopularResource) MoveState(ctx context.Context) []resource.StateMover {
+	return importListMover(ctx, importListTraktPopularImplementation, func(importList *ImportListResourceModel) interface{} {
+		moved := ImportListTraktPopular{Timeouts: importList.Timeouts}
+		moved.fromImportList(&importList.ImportList)
+
+		return &moved
+	})
+}
+
 func (i *ImportListTraktPopular) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
 	genericImportList.write(ctx, importList, diags)
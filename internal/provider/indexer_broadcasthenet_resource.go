@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerBroadcastheNetResource{}
 	_ resource.ResourceWithImportState = &IndexerBroadcastheNetResource{}
+	_ resource.ResourceWithMoveState   = &IndexerBroadcastheNetResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerBroadcastheNetResource{}
 )
 
 func NewIndexerBroadcastheNetResource() resource.Resource {
@@ -35,26 +38,30 @@ func NewIndexerBroadcastheNetResource() resource.Resource {
 
 // IndexerBroadcastheNetResource defines the BroadcastheNet indexer implementation.
 type IndexerBroadcastheNetResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // IndexerBroadcastheNet describes the BroadcastheNet indexer data model.
 type IndexerBroadcastheNet struct {
-	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
-	Tags                    types.Set     `tfsdk:"tags"`
-	Name                    types.String  `tfsdk:"name"`
-	APIKey                  types.String  `tfsdk:"api_key"`
-	BaseURL                 types.String  `tfsdk:"base_url"`
-	ID                      types.Int64   `tfsdk:"id"`
-	Priority                types.Int64   `tfsdk:"priority"`
-	DownloadClientID        types.Int64   `tfsdk:"download_client_id"`
-	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
-	SeasonPackSeedTime      types.Int64   `tfsdk:"season_pack_seed_time"`
-	SeedTime                types.Int64   `tfsdk:"seed_time"`
-	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
-	EnableRss               types.Bool    `tfsdk:"enable_rss"`
-	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	SeedRatio               types.Float64  `tfsdk:"seed_ratio"`
+	Tags                    types.Set      `tfsdk:"tags"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+	Name                    types.String   `tfsdk:"name"`
+	APIKey                  types.String   `tfsdk:"api_key"`
+	BaseURL                 types.String   `tfsdk:"base_url"`
+	ID                      types.Int64    `tfsdk:"id"`
+	Priority                types.Int64    `tfsdk:"priority"`
+	DownloadClientID        types.Int64    `tfsdk:"download_client_id"`
+	MinimumSeeders          types.Int64    `tfsdk:"minimum_seeders"`
+	SeasonPackSeedTime      types.Int64    `tfsdk:"season_pack_seed_time"`
+	SeedTime                types.Int64    `tfsdk:"seed_time"`
+	EnableAutomaticSearch   types.Bool     `tfsdk:"enable_automatic_search"`
+	EnableRss               types.Bool     `tfsdk:"enable_rss"`
+	EnableInteractiveSearch types.Bool     `tfsdk:"enable_interactive_search"`
 }
 
 func (i IndexerBroadcastheNet) toIndexer() *Indexer {
@@ -100,7 +107,7 @@ func (r *IndexerBroadcastheNetResource) Metadata(_ context.Context, req resource
 	resp.TypeName = req.ProviderTypeName + "_" + indexerBroadcastheNetResourceName
 }
 
-func (r *IndexerBroadcastheNetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerBroadcastheNetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer BroadcastheNet resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) and [BroadcastheNet](https://wiki.servarr.com/sonarr/supported#broadcasthenet).",
 		Attributes: map[string]schema.Attribute{
@@ -177,6 +184,14 @@ func (r *IndexerBroadcastheNetResource) Schema(_ context.Context, _ resource.Sch
 				Sensitive:           true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -184,9 +199,18 @@ func (r *IndexerBroadcastheNetResource) Configure(ctx context.Context, req resou
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *IndexerBroadcastheNetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), downloadClientReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerBroadcastheNetResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerBroadcastheNetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerBroadcastheNet
@@ -197,20 +221,27 @@ func (r *IndexerBroadcastheNetResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerBroadcastheNetResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Create, indexerBroadcastheNetResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+indexerBroadcastheNetResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerBroadcastheNetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -223,18 +254,33 @@ func (r *IndexerBroadcastheNetResource) Read(ctx context.Context, req resource.R
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get IndexerBroadcastheNet current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(indexerBroadcastheNetResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerBroadcastheNetResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, indexer.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, indexerSecretTest(ctx, r.client, *response), indexerBroadcastheNetResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+indexerBroadcastheNetResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerBroadcastheNetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -247,33 +293,54 @@ func (r *IndexerBroadcastheNetResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute, helpers.ClearedFields(indexer, indexerFields), indexerBroadcastheNetResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerBroadcastheNetResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Update, indexerBroadcastheNetResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+indexerBroadcastheNetResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerBroadcastheNetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete IndexerBroadcastheNet current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerBroadcastheNetResourceName, err))
 
@@ -285,10 +352,19 @@ func (r *IndexerBroadcastheNetResource) Delete(ctx context.Context, req resource
 }
 
 func (r *IndexerBroadcastheNetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	indexerImporter(r.client, indexerBroadcastheNetImplementation).importState(ctx, r.auth, indexerBroadcastheNetResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+indexerBroadcastheNetResourceName+": "+req.ID)
 }
 
+func (r *IndexerBroadcastheNetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return indexerMover(ctx, indexerBroadcastheNetImplementation, func(indexer *IndexerResourceModel) interface{} {
+		moved := IndexerBroadcastheNet{Timeouts: indexer.Timeouts}
+		moved.fromIndexer(&indexer.Indexer)
+
+		return &moved
+	})
+}
+
 func (i *IndexerBroadcastheNet) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,6 +21,7 @@ const indexerConfigResourceName = "indexer_config"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &IndexerConfigResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerConfigResource{}
 	_ resource.ResourceWithImportState = &IndexerConfigResource{}
 )
 
@@ -29,8 +31,9 @@ func NewIndexerConfigResource() resource.Resource {
 
 // IndexerConfigResource defines the indexer config implementation.
 type IndexerConfigResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // IndexerConfig describes the indexer config data model.
@@ -42,11 +45,17 @@ type IndexerConfig struct {
 	RssSyncInterval types.Int64 `tfsdk:"rss_sync_interval"`
 }
 
+// IndexerConfigResourceModel describes the indexer config resource data model, including timeouts.
+type IndexerConfigResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	IndexerConfig
+}
+
 func (r *IndexerConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + indexerConfigResourceName
 }
 
-func (r *IndexerConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Config resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#options) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -74,6 +83,13 @@ func (r *IndexerConfigResource) Schema(_ context.Context, _ resource.SchemaReque
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -81,12 +97,17 @@ func (r *IndexerConfigResource) Configure(ctx context.Context, req resource.Conf
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *IndexerConfigResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerConfigResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var config *IndexerConfig
+	var config *IndexerConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -94,14 +115,17 @@ func (r *IndexerConfigResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, config.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Create resource
 	request := config.read()
 	request.SetId(1)
 
 	// Create new IndexerConfig
-	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(r.auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
+	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(ctx, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, indexerConfigResourceName, err)
 
 		return
 	}
@@ -114,7 +138,7 @@ func (r *IndexerConfigResource) Create(ctx context.Context, req resource.CreateR
 
 func (r *IndexerConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var config *IndexerConfig
+	var config *IndexerConfigResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &config)...)
 
@@ -122,8 +146,11 @@ func (r *IndexerConfigResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, config.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get indexerConfig current value
-	response, _, err := r.client.IndexerConfigAPI.GetIndexerConfig(r.auth).Execute()
+	response, _, err := r.client.IndexerConfigAPI.GetIndexerConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerConfigResourceName, err))
 
@@ -138,7 +165,7 @@ func (r *IndexerConfigResource) Read(ctx context.Context, req resource.ReadReque
 
 func (r *IndexerConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var config *IndexerConfig
+	var config *IndexerConfigResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &config)...)
 
@@ -146,13 +173,23 @@ func (r *IndexerConfigResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, config.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
 	request := config.read()
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerConfigAPI.GetIndexerConfig(ctx).Execute, nil, indexerConfigResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerConfig
-	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(r.auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
+	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(ctx, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerConfigResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, indexerConfigResourceName, err)
 
 		return
 	}
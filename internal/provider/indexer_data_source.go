@@ -178,7 +178,7 @@ func (d *IndexerDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	// Get indexer current value
-	response, _, err := d.client.IndexerAPI.ListIndexer(d.auth).Execute()
+	response, _, err := d.client.IndexerAPI.ListIndexer(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerDataSourceName, err))
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerFanzubResource{}
 	_ resource.ResourceWithImportState = &IndexerFanzubResource{}
+	_ resource.ResourceWithMoveState   = &IndexerFanzubResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerFanzubResource{}
 )
 
 func NewIndexerFanzubResource() resource.Resource {
@@ -35,22 +38,25 @@ func NewIndexerFanzubResource() resource.Resource {
 
 // IndexerFanzubResource defines the Fanzub indexer implementation.
 type IndexerFanzubResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // IndexerFanzub describes the Fanzub indexer data model.
 type IndexerFanzub struct {
-	Tags                      types.Set    `tfsdk:"tags"`
-	BaseURL                   types.String `tfsdk:"base_url"`
-	Name                      types.String `tfsdk:"name"`
-	ID                        types.Int64  `tfsdk:"id"`
-	DownloadClientID          types.Int64  `tfsdk:"download_client_id"`
-	Priority                  types.Int64  `tfsdk:"priority"`
-	AnimeStandardFormatSearch types.Bool   `tfsdk:"anime_standard_format_search"`
-	EnableRss                 types.Bool   `tfsdk:"enable_rss"`
-	EnableInteractiveSearch   types.Bool   `tfsdk:"enable_interactive_search"`
-	EnableAutomaticSearch     types.Bool   `tfsdk:"enable_automatic_search"`
+	Tags                      types.Set      `tfsdk:"tags"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	BaseURL                   types.String   `tfsdk:"base_url"`
+	Name                      types.String   `tfsdk:"name"`
+	ID                        types.Int64    `tfsdk:"id"`
+	DownloadClientID          types.Int64    `tfsdk:"download_client_id"`
+	Priority                  types.Int64    `tfsdk:"priority"`
+	AnimeStandardFormatSearch types.Bool     `tfsdk:"anime_standard_format_search"`
+	EnableRss                 types.Bool     `tfsdk:"enable_rss"`
+	EnableInteractiveSearch   types.Bool     `tfsdk:"enable_interactive_search"`
+	EnableAutomaticSearch     types.Bool     `tfsdk:"enable_automatic_search"`
 }
 
 func (i IndexerFanzub) toIndexer() *Indexer {
@@ -88,7 +94,7 @@ func (r *IndexerFanzubResource) Metadata(_ context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_" + indexerFanzubResourceName
 }
 
-func (r *IndexerFanzubResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerFanzubResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Fanzub resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) and [Fanzub](https://wiki.servarr.com/sonarr/supported#fanzub).",
 		Attributes: map[string]schema.Attribute{
@@ -146,6 +152,14 @@ func (r *IndexerFanzubResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -153,9 +167,17 @@ func (r *IndexerFanzubResource) Configure(ctx context.Context, req resource.Conf
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *IndexerFanzubResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), downloadClientReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerFanzubResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerFanzubResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerFanzub
@@ -166,12 +188,17 @@ func (r *IndexerFanzubResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFanzubResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Create, indexerFanzubResourceName, err)
 
 		return
 	}
@@ -180,6 +207,7 @@ func (r *IndexerFanzubResource) Create(ctx context.Context, req resource.CreateR
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerFanzubResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -192,9 +220,19 @@ func (r *IndexerFanzubResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get IndexerFanzub current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(indexerFanzubResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFanzubResourceName, err))
 
 		return
@@ -204,6 +242,7 @@ func (r *IndexerFanzubResource) Read(ctx context.Context, req resource.ReadReque
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerFanzubResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -216,12 +255,24 @@ func (r *IndexerFanzubResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute, helpers.ClearedFields(indexer, indexerFields), indexerFanzubResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFanzubResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Update, indexerFanzubResourceName, err)
 
 		return
 	}
@@ -230,19 +281,27 @@ func (r *IndexerFanzubResource) Update(ctx context.Context, req resource.UpdateR
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerFanzubResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete IndexerFanzub current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerFanzubResourceName, err))
 
@@ -254,10 +313,19 @@ func (r *IndexerFanzubResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *IndexerFanzubResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	indexerImporter(r.client, indexerFanzubImplementation).importState(ctx, r.auth, indexerFanzubResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+indexerFanzubResourceName+": "+req.ID)
 }
 
+func (r *IndexerFanzubResource) MoveState(ctx context.Context) []resource.StateMover {
+	return indexerMover(ctx, indexerFanzubImplementation, func(indexer *IndexerResourceModel) interface{} {
+		moved := IndexerFanzub{Timeouts: indexer.Timeouts}
+		moved.fromIndexer(&indexer.Indexer)
+
+		return &moved
+	})
+}
+
 func (i *IndexerFanzub) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
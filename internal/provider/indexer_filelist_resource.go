@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerFilelistResource{}
 	_ resource.ResourceWithImportState = &IndexerFilelistResource{}
+	_ resource.ResourceWithMoveState   = &IndexerFilelistResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerFilelistResource{}
 )
 
 func NewIndexerFilelistResource() resource.Resource {
@@ -35,29 +38,33 @@ func NewIndexerFilelistResource() resource.Resource {
 
 // IndexerFilelistResource defines the Filelist indexer implementation.
 type IndexerFilelistResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // IndexerFilelist describes the Filelist indexer data model.
 type IndexerFilelist struct {
-	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
-	Tags                    types.Set     `tfsdk:"tags"`
-	Categories              types.Set     `tfsdk:"categories"`
-	AnimeCategories         types.Set     `tfsdk:"anime_categories"`
-	Name                    types.String  `tfsdk:"name"`
-	BaseURL                 types.String  `tfsdk:"base_url"`
-	Username                types.String  `tfsdk:"username"`
-	Passkey                 types.String  `tfsdk:"passkey"`
-	ID                      types.Int64   `tfsdk:"id"`
-	DownloadClientID        types.Int64   `tfsdk:"download_client_id"`
-	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
-	SeasonPackSeedTime      types.Int64   `tfsdk:"season_pack_seed_time"`
-	SeedTime                types.Int64   `tfsdk:"seed_time"`
-	Priority                types.Int64   `tfsdk:"priority"`
-	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
-	EnableRss               types.Bool    `tfsdk:"enable_rss"`
-	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	SeedRatio               types.Float64  `tfsdk:"seed_ratio"`
+	Tags                    types.Set      `tfsdk:"tags"`
+	Categories              types.Set      `tfsdk:"categories"`
+	AnimeCategories         types.Set      `tfsdk:"anime_categories"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+	Name                    types.String   `tfsdk:"name"`
+	BaseURL                 types.String   `tfsdk:"base_url"`
+	Username                types.String   `tfsdk:"username"`
+	Passkey                 types.String   `tfsdk:"passkey"`
+	ID                      types.Int64    `tfsdk:"id"`
+	DownloadClientID        types.Int64    `tfsdk:"download_client_id"`
+	MinimumSeeders          types.Int64    `tfsdk:"minimum_seeders"`
+	SeasonPackSeedTime      types.Int64    `tfsdk:"season_pack_seed_time"`
+	SeedTime                types.Int64    `tfsdk:"seed_time"`
+	Priority                types.Int64    `tfsdk:"priority"`
+	EnableAutomaticSearch   types.Bool     `tfsdk:"enable_automatic_search"`
+	EnableRss               types.Bool     `tfsdk:"enable_rss"`
+	EnableInteractiveSearch types.Bool     `tfsdk:"enable_interactive_search"`
 }
 
 func (i IndexerFilelist) toIndexer() *Indexer {
@@ -109,7 +116,7 @@ func (r *IndexerFilelistResource) Metadata(_ context.Context, req resource.Metad
 	resp.TypeName = req.ProviderTypeName + "_" + indexerFilelistResourceName
 }
 
-func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerFilelistResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer FileList resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) and [FileList](https://wiki.servarr.com/sonarr/supported#filelist).",
 		Attributes: map[string]schema.Attribute{
@@ -203,6 +210,14 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				ElementType:         types.Int64Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -210,9 +225,18 @@ func (r *IndexerFilelistResource) Configure(ctx context.Context, req resource.Co
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *IndexerFilelistResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), downloadClientReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerFilelistResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerFilelist
@@ -223,20 +247,27 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Create, indexerFilelistResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -249,18 +280,33 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get IndexerFilelist current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(indexerFilelistResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFilelistResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, indexer.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, indexerSecretTest(ctx, r.client, *response), indexerFilelistResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -273,33 +319,54 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute, helpers.ClearedFields(indexer, indexerFields), indexerFilelistResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFilelistResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Update, indexerFilelistResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+indexerFilelistResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete IndexerFilelist current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerFilelistResourceName, err))
 
@@ -311,10 +378,19 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *IndexerFilelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	indexerImporter(r.client, indexerFilelistImplementation).importState(ctx, r.auth, indexerFilelistResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+indexerFilelistResourceName+": "+req.ID)
 }
 
+func (r *IndexerFilelistResource) MoveState(ctx context.Context) []resource.StateMover {
+	return indexerMover(ctx, indexerFilelistImplementation, func(indexer *IndexerResourceModel) interface{} {
+		moved := IndexerFilelist{Timeouts: indexer.Timeouts}
+		moved.fromIndexer(&indexer.Indexer)
+
+		return &moved
+	})
+}
+
 func (i *IndexerFilelist) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
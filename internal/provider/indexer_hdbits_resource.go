@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerHdbitsResource{}
 	_ resource.ResourceWithImportState = &IndexerHdbitsResource{}
+	_ resource.ResourceWithMoveState   = &IndexerHdbitsResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerHdbitsResource{}
 )
 
 func NewIndexerHdbitsResource() resource.Resource {
@@ -35,27 +38,31 @@ func NewIndexerHdbitsResource() resource.Resource {
 
 // IndexerHdbitsResource defines the Hdbits indexer implementation.
 type IndexerHdbitsResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // IndexerHdbits describes the Hdbits indexer data model.
 type IndexerHdbits struct {
-	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
-	Tags                    types.Set     `tfsdk:"tags"`
-	Name                    types.String  `tfsdk:"name"`
-	BaseURL                 types.String  `tfsdk:"base_url"`
-	Username                types.String  `tfsdk:"username"`
-	APIKey                  types.String  `tfsdk:"api_key"`
-	ID                      types.Int64   `tfsdk:"id"`
-	DownloadClientID        types.Int64   `tfsdk:"download_client_id"`
-	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
-	SeasonPackSeedTime      types.Int64   `tfsdk:"season_pack_seed_time"`
-	SeedTime                types.Int64   `tfsdk:"seed_time"`
-	Priority                types.Int64   `tfsdk:"priority"`
-	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
-	EnableRss               types.Bool    `tfsdk:"enable_rss"`
-	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	SeedRatio               types.Float64  `tfsdk:"seed_ratio"`
+	Tags                    types.Set      `tfsdk:"tags"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+	Name                    types.String   `tfsdk:"name"`
+	BaseURL                 types.String   `tfsdk:"base_url"`
+	Username                types.String   `tfsdk:"username"`
+	APIKey                  types.String   `tfsdk:"api_key"`
+	ID                      types.Int64    `tfsdk:"id"`
+	DownloadClientID        types.Int64    `tfsdk:"download_client_id"`
+	MinimumSeeders          types.Int64    `tfsdk:"minimum_seeders"`
+	SeasonPackSeedTime      types.Int64    `tfsdk:"season_pack_seed_time"`
+	SeedTime                types.Int64    `tfsdk:"seed_time"`
+	Priority                types.Int64    `tfsdk:"priority"`
+	EnableAutomaticSearch   types.Bool     `tfsdk:"enable_automatic_search"`
+	EnableRss               types.Bool     `tfsdk:"enable_rss"`
+	EnableInteractiveSearch types.Bool     `tfsdk:"enable_interactive_search"`
 }
 
 func (i IndexerHdbits) toIndexer() *Indexer {
@@ -103,7 +110,7 @@ func (r *IndexerHdbitsResource) Metadata(_ context.Context, req resource.Metadat
 	resp.TypeName = req.ProviderTypeName + "_" + indexerHdbitsResourceName
 }
 
-func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerHdbitsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer HDBits resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) and [HDBits](https://wiki.servarr.com/sonarr/supported#hdbits).",
 		Attributes: map[string]schema.Attribute{
@@ -185,6 +192,14 @@ func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaReque
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -192,9 +207,18 @@ func (r *IndexerHdbitsResource) Configure(ctx context.Context, req resource.Conf
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *IndexerHdbitsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), downloadClientReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerHdbitsResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerHdbits
@@ -205,20 +229,27 @@ func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerHdbitsResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Create, indexerHdbitsResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+indexerHdbitsResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -231,18 +262,33 @@ func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get IndexerHdbits current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(indexerHdbitsResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerHdbitsResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, indexer.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, indexerSecretTest(ctx, r.client, *response), indexerHdbitsResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+indexerHdbitsResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -255,33 +301,54 @@ func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute, helpers.ClearedFields(indexer, indexerFields), indexerHdbitsResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerHdbitsResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Update, indexerHdbitsResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+indexerHdbitsResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete IndexerHdbits current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerHdbitsResourceName, err))
 
@@ -293,10 +360,19 @@ func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *IndexerHdbitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	indexerImporter(r.client, indexerHdbitsImplementation).importState(ctx, r.auth, indexerHdbitsResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+indexerHdbitsResourceName+": "+req.ID)
 }
 
+func (r *IndexerHdbitsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return indexerMover(ctx, indexerHdbitsImplementation, func(indexer *IndexerResourceModel) interface{} {
+		moved := IndexerHdbits{Timeouts: indexer.Timeouts}
+		moved.fromIndexer(&indexer.Indexer)
+
+		return &moved
+	})
+}
+
 func (i *IndexerHdbits) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
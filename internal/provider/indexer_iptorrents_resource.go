@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerIptorrentsResource{}
 	_ resource.ResourceWithImportState = &IndexerIptorrentsResource{}
+	_ resource.ResourceWithMoveState   = &IndexerIptorrentsResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerIptorrentsResource{}
 )
 
 func NewIndexerIptorrentsResource() resource.Resource {
@@ -35,25 +38,28 @@ func NewIndexerIptorrentsResource() resource.Resource {
 
 // IndexerIptorrentsResource defines the Iptorrents indexer implementation.
 type IndexerIptorrentsResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // IndexerIptorrents describes the Iptorrents indexer data model.
 type IndexerIptorrents struct {
-	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
-	Tags                    types.Set     `tfsdk:"tags"`
-	Name                    types.String  `tfsdk:"name"`
-	BaseURL                 types.String  `tfsdk:"base_url"`
-	Priority                types.Int64   `tfsdk:"priority"`
-	DownloadClientID        types.Int64   `tfsdk:"download_client_id"`
-	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
-	SeasonPackSeedTime      types.Int64   `tfsdk:"season_pack_seed_time"`
-	SeedTime                types.Int64   `tfsdk:"seed_time"`
-	ID                      types.Int64   `tfsdk:"id"`
-	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
-	EnableRss               types.Bool    `tfsdk:"enable_rss"`
-	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	SeedRatio               types.Float64  `tfsdk:"seed_ratio"`
+	Tags                    types.Set      `tfsdk:"tags"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+	Name                    types.String   `tfsdk:"name"`
+	BaseURL                 types.String   `tfsdk:"base_url"`
+	Priority                types.Int64    `tfsdk:"priority"`
+	DownloadClientID        types.Int64    `tfsdk:"download_client_id"`
+	MinimumSeeders          types.Int64    `tfsdk:"minimum_seeders"`
+	SeasonPackSeedTime      types.Int64    `tfsdk:"season_pack_seed_time"`
+	SeedTime                types.Int64    `tfsdk:"seed_time"`
+	ID                      types.Int64    `tfsdk:"id"`
+	EnableAutomaticSearch   types.Bool     `tfsdk:"enable_automatic_search"`
+	EnableRss               types.Bool     `tfsdk:"enable_rss"`
+	EnableInteractiveSearch types.Bool     `tfsdk:"enable_interactive_search"`
 }
 
 func (i IndexerIptorrents) toIndexer() *Indexer {
@@ -97,7 +103,7 @@ func (r *IndexerIptorrentsResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_" + indexerIptorrentsResourceName
 }
 
-func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerIptorrentsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer IP Torrents resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) and [IP Torrents](https://wiki.servarr.com/sonarr/supported#iptorrents).",
 		Attributes: map[string]schema.Attribute{
@@ -169,6 +175,14 @@ func (r *IndexerIptorrentsResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -176,9 +190,17 @@ func (r *IndexerIptorrentsResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *IndexerIptorrentsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), downloadClientReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerIptorrentsResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerIptorrents
@@ -189,12 +211,17 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerIptorrentsResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Create, indexerIptorrentsResourceName, err)
 
 		return
 	}
@@ -203,6 +230,7 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -215,9 +243,19 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get IndexerIptorrents current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(indexerIptorrentsResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerIptorrentsResourceName, err))
 
 		return
@@ -227,6 +265,7 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -239,12 +278,24 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute, helpers.ClearedFields(indexer, indexerFields), indexerIptorrentsResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerIptorrentsResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Update, indexerIptorrentsResourceName, err)
 
 		return
 	}
@@ -253,19 +304,27 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete IndexerIptorrents current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerIptorrentsResourceName, err))
 
@@ -277,10 +336,19 @@ func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerIptorrentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	indexerImporter(r.client, indexerIptorrentsImplementation).importState(ctx, r.auth, indexerIptorrentsResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+indexerIptorrentsResourceName+": "+req.ID)
 }
 
+func (r *IndexerIptorrentsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return indexerMover(ctx, indexerIptorrentsImplementation, func(indexer *IndexerResourceModel) interface{} {
+		moved := IndexerIptorrents{Timeouts: indexer.Timeouts}
+		moved.fromIndexer(&indexer.Indexer)
+
+		return &moved
+	})
+}
+
 func (i *IndexerIptorrents) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerNewznabResource{}
 	_ resource.ResourceWithImportState = &IndexerNewznabResource{}
+	_ resource.ResourceWithMoveState   = &IndexerNewznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerNewznabResource{}
 )
 
 func NewIndexerNewznabResource() resource.Resource {
@@ -35,27 +38,31 @@ func NewIndexerNewznabResource() resource.Resource {
 
 // IndexerNewznabResource defines the Newznab indexer implementation.
 type IndexerNewznabResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // IndexerNewznab describes the Newznab indexer data model.
 type IndexerNewznab struct {
-	Tags                      types.Set    `tfsdk:"tags"`
-	Categories                types.Set    `tfsdk:"categories"`
-	AnimeCategories           types.Set    `tfsdk:"anime_categories"`
-	AdditionalParameters      types.String `tfsdk:"additional_parameters"`
-	BaseURL                   types.String `tfsdk:"base_url"`
-	APIPath                   types.String `tfsdk:"api_path"`
-	APIKey                    types.String `tfsdk:"api_key"`
-	Name                      types.String `tfsdk:"name"`
-	ID                        types.Int64  `tfsdk:"id"`
-	DownloadClientID          types.Int64  `tfsdk:"download_client_id"`
-	Priority                  types.Int64  `tfsdk:"priority"`
-	AnimeStandardFormatSearch types.Bool   `tfsdk:"anime_standard_format_search"`
-	EnableRss                 types.Bool   `tfsdk:"enable_rss"`
-	EnableInteractiveSearch   types.Bool   `tfsdk:"enable_interactive_search"`
-	EnableAutomaticSearch     types.Bool   `tfsdk:"enable_automatic_search"`
+	Tags                      types.Set      `tfsdk:"tags"`
+	Categories                types.Set      `tfsdk:"categories"`
+	AnimeCategories           types.Set      `tfsdk:"anime_categories"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	AdditionalParameters      types.String   `tfsdk:"additional_parameters"`
+	BaseURL                   types.String   `tfsdk:"base_url"`
+	APIPath                   types.String   `tfsdk:"api_path"`
+	APIKey                    types.String   `tfsdk:"api_key"`
+	Name                      types.String   `tfsdk:"name"`
+	ID                        types.Int64    `tfsdk:"id"`
+	DownloadClientID          types.Int64    `tfsdk:"download_client_id"`
+	Priority                  types.Int64    `tfsdk:"priority"`
+	AnimeStandardFormatSearch types.Bool     `tfsdk:"anime_standard_format_search"`
+	EnableRss                 types.Bool     `tfsdk:"enable_rss"`
+	EnableInteractiveSearch   types.Bool     `tfsdk:"enable_interactive_search"`
+	EnableAutomaticSearch     types.Bool     `tfsdk:"enable_automatic_search"`
 }
 
 func (i IndexerNewznab) toIndexer() *Indexer {
@@ -103,7 +110,7 @@ func (r *IndexerNewznabResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_" + indexerNewznabResourceName
 }
 
-func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerNewznabResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Newznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) and [Newznab](https://wiki.servarr.com/sonarr/supported#newznab).",
 		Attributes: map[string]schema.Attribute{
@@ -189,6 +196,14 @@ func (r *IndexerNewznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				ElementType:         types.Int64Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -196,9 +211,18 @@ func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *IndexerNewznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), downloadClientReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerNewznabResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerNewznab
@@ -209,20 +233,27 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Create, indexerNewznabResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -235,18 +266,33 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get IndexerNewznab current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(indexerNewznabResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNewznabResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, indexer.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, indexerSecretTest(ctx, r.client, *response), indexerNewznabResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -259,33 +305,54 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute, helpers.ClearedFields(indexer, indexerFields), indexerNewznabResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNewznabResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Update, indexerNewznabResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+indexerNewznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete IndexerNewznab current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerNewznabResourceName, err))
 
@@ -297,10 +364,19 @@ func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	indexerImporter(r.client, indexerNewznabImplementation).importState(ctx, r.auth, indexerNewznabResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
 }
 
+func (r *IndexerNewznabResource) MoveState(ctx context.Context) []resource.StateMover {
+	return indexerMover(ctx, indexerNewznabImplementation, func(indexer *IndexerResourceModel) interface{} {
+		moved := IndexerNewznab{Timeouts: indexer.Timeouts}
+		moved.fromIndexer(&indexer.Indexer)
+
+		return &moved
+	})
+}
+
 func (i *IndexerNewznab) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -41,6 +43,25 @@ func TestAccIndexerResource(t *testing.T) {
 					resource.TestCheckResourceAttr("sonarr_indexer.test", "enable_automatic_search", "true"),
 				),
 			},
+			// Out of band deletion testing
+			{
+				Config: testAccIndexerResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceDisappears("sonarr_indexer.test", func(ctx context.Context, client *sonarr.APIClient, id int32) error {
+						_, err := client.IndexerAPI.DeleteIndexer(ctx, id).Execute()
+
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Re-create after out of band deletion
+			{
+				Config: testAccIndexerResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_indexer.test", "id"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "sonarr_indexer.test",
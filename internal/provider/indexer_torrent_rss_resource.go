@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerTorrentRssResource{}
 	_ resource.ResourceWithImportState = &IndexerTorrentRssResource{}
+	_ resource.ResourceWithMoveState   = &IndexerTorrentRssResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorrentRssResource{}
 )
 
 func NewIndexerTorrentRssResource() resource.Resource {
@@ -35,27 +38,30 @@ func NewIndexerTorrentRssResource() resource.Resource {
 
 // IndexerTorrentRssResource defines the TorrentRss indexer implementation.
 type IndexerTorrentRssResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // IndexerTorrentRss describes the TorrentRss indexer data model.
 type IndexerTorrentRss struct {
-	SeedRatio               types.Float64 `tfsdk:"seed_ratio"`
-	Tags                    types.Set     `tfsdk:"tags"`
-	Name                    types.String  `tfsdk:"name"`
-	BaseURL                 types.String  `tfsdk:"base_url"`
-	Cookie                  types.String  `tfsdk:"cookie"`
-	DownloadClientID        types.Int64   `tfsdk:"download_client_id"`
-	ID                      types.Int64   `tfsdk:"id"`
-	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
-	SeasonPackSeedTime      types.Int64   `tfsdk:"season_pack_seed_time"`
-	SeedTime                types.Int64   `tfsdk:"seed_time"`
-	Priority                types.Int64   `tfsdk:"priority"`
-	AllowZeroSize           types.Bool    `tfsdk:"allow_zero_size"`
-	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
-	EnableRss               types.Bool    `tfsdk:"enable_rss"`
-	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	SeedRatio               types.Float64  `tfsdk:"seed_ratio"`
+	Tags                    types.Set      `tfsdk:"tags"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+	Name                    types.String   `tfsdk:"name"`
+	BaseURL                 types.String   `tfsdk:"base_url"`
+	Cookie                  types.String   `tfsdk:"cookie"`
+	DownloadClientID        types.Int64    `tfsdk:"download_client_id"`
+	ID                      types.Int64    `tfsdk:"id"`
+	MinimumSeeders          types.Int64    `tfsdk:"minimum_seeders"`
+	SeasonPackSeedTime      types.Int64    `tfsdk:"season_pack_seed_time"`
+	SeedTime                types.Int64    `tfsdk:"seed_time"`
+	Priority                types.Int64    `tfsdk:"priority"`
+	AllowZeroSize           types.Bool     `tfsdk:"allow_zero_size"`
+	EnableAutomaticSearch   types.Bool     `tfsdk:"enable_automatic_search"`
+	EnableRss               types.Bool     `tfsdk:"enable_rss"`
+	EnableInteractiveSearch types.Bool     `tfsdk:"enable_interactive_search"`
 }
 
 func (i IndexerTorrentRss) toIndexer() *Indexer {
@@ -103,7 +109,7 @@ func (r *IndexerTorrentRssResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_" + indexerTorrentRssResourceName
 }
 
-func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerTorrentRssResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Torrent RSS resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) and [Torrent RSS](https://wiki.servarr.com/sonarr/supported#torrentrssindexer).",
 		Attributes: map[string]schema.Attribute{
@@ -185,6 +191,14 @@ func (r *IndexerTorrentRssResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -192,9 +206,17 @@ func (r *IndexerTorrentRssResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *IndexerTorrentRssResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), downloadClientReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerTorrentRssResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorrentRss
@@ -205,12 +227,17 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentRssResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Create, indexerTorrentRssResourceName, err)
 
 		return
 	}
@@ -219,6 +246,7 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -231,9 +259,19 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get IndexerTorrentRss current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(indexerTorrentRssResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentRssResourceName, err))
 
 		return
@@ -243,6 +281,7 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -255,12 +294,24 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute, helpers.ClearedFields(indexer, indexerFields), indexerTorrentRssResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorrentRssResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Update, indexerTorrentRssResourceName, err)
 
 		return
 	}
@@ -269,19 +320,27 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete IndexerTorrentRss current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorrentRssResourceName, err))
 
@@ -293,10 +352,19 @@ func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerTorrentRssResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	indexerImporter(r.client, indexerTorrentRssImplementation).importState(ctx, r.auth, indexerTorrentRssResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+indexerTorrentRssResourceName+": "+req.ID)
 }
 
+func (r *IndexerTorrentRssResource) MoveState(ctx context.Context) []resource.StateMover {
+	return indexerMover(ctx, indexerTorrentRssImplementation, func(indexer *IndexerResourceModel) interface{} {
+		moved := IndexerTorrentRss{Timeouts: indexer.Timeouts}
+		moved.fromIndexer(&indexer.Indexer)
+
+		return &moved
+	})
+}
+
 func (i *IndexerTorrentRss) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &IndexerTorznabResource{}
 	_ resource.ResourceWithImportState = &IndexerTorznabResource{}
+	_ resource.ResourceWithMoveState   = &IndexerTorznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorznabResource{}
 )
 
 func NewIndexerTorznabResource() resource.Resource {
@@ -35,31 +38,35 @@ func NewIndexerTorznabResource() resource.Resource {
 
 // IndexerTorznabResource defines the Torznab indexer implementation.
 type IndexerTorznabResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // IndexerTorznab describes the Torznab indexer data model.
 type IndexerTorznab struct {
-	SeedRatio                 types.Float64 `tfsdk:"seed_ratio"`
-	Categories                types.Set     `tfsdk:"categories"`
-	AnimeCategories           types.Set     `tfsdk:"anime_categories"`
-	Tags                      types.Set     `tfsdk:"tags"`
-	Name                      types.String  `tfsdk:"name"`
-	BaseURL                   types.String  `tfsdk:"base_url"`
-	APIPath                   types.String  `tfsdk:"api_path"`
-	APIKey                    types.String  `tfsdk:"api_key"`
-	AdditionalParameters      types.String  `tfsdk:"additional_parameters"`
-	Priority                  types.Int64   `tfsdk:"priority"`
-	DownloadClientID          types.Int64   `tfsdk:"download_client_id"`
-	MinimumSeeders            types.Int64   `tfsdk:"minimum_seeders"`
-	SeasonPackSeedTime        types.Int64   `tfsdk:"season_pack_seed_time"`
-	SeedTime                  types.Int64   `tfsdk:"seed_time"`
-	ID                        types.Int64   `tfsdk:"id"`
-	AnimeStandardFormatSearch types.Bool    `tfsdk:"anime_standard_format_search"`
-	EnableAutomaticSearch     types.Bool    `tfsdk:"enable_automatic_search"`
-	EnableRss                 types.Bool    `tfsdk:"enable_rss"`
-	EnableInteractiveSearch   types.Bool    `tfsdk:"enable_interactive_search"`
+	SeedRatio                 types.Float64  `tfsdk:"seed_ratio"`
+	Categories                types.Set      `tfsdk:"categories"`
+	AnimeCategories           types.Set      `tfsdk:"anime_categories"`
+	Tags                      types.Set      `tfsdk:"tags"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	Name                      types.String   `tfsdk:"name"`
+	BaseURL                   types.String   `tfsdk:"base_url"`
+	APIPath                   types.String   `tfsdk:"api_path"`
+	APIKey                    types.String   `tfsdk:"api_key"`
+	AdditionalParameters      types.String   `tfsdk:"additional_parameters"`
+	Priority                  types.Int64    `tfsdk:"priority"`
+	DownloadClientID          types.Int64    `tfsdk:"download_client_id"`
+	MinimumSeeders            types.Int64    `tfsdk:"minimum_seeders"`
+	SeasonPackSeedTime        types.Int64    `tfsdk:"season_pack_seed_time"`
+	SeedTime                  types.Int64    `tfsdk:"seed_time"`
+	ID                        types.Int64    `tfsdk:"id"`
+	AnimeStandardFormatSearch types.Bool     `tfsdk:"anime_standard_format_search"`
+	EnableAutomaticSearch     types.Bool     `tfsdk:"enable_automatic_search"`
+	EnableRss                 types.Bool     `tfsdk:"enable_rss"`
+	EnableInteractiveSearch   types.Bool     `tfsdk:"enable_interactive_search"`
 }
 
 func (i IndexerTorznab) toIndexer() *Indexer {
@@ -115,7 +122,7 @@ func (r *IndexerTorznabResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_" + indexerTorznabResourceName
 }
 
-func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IndexerTorznabResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nIndexer Torznab resource.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) and [Torznab](https://wiki.servarr.com/sonarr/supported#torznab).",
 		Attributes: map[string]schema.Attribute{
@@ -220,6 +227,14 @@ func (r *IndexerTorznabResource) Schema(_ context.Context, _ resource.SchemaRequ
 				ElementType:         types.Int64Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -227,9 +242,18 @@ func (r *IndexerTorznabResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *IndexerTorznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), downloadClientReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, indexerTorznabResourceName, req.State, resp.Plan)...)
+}
+
 func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorznab
@@ -240,20 +264,27 @@ func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new IndexerTorznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.CreateIndexer(ctx).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorznabResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Create, indexerTorznabResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerTorznabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -266,18 +297,33 @@ func (r *IndexerTorznabResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get IndexerTorznab current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(indexerTorznabResourceName, indexer.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorznabResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, indexer.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, indexerSecretTest(ctx, r.client, *response), indexerTorznabResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -290,33 +336,54 @@ func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, indexer.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update IndexerTorznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.IndexerAPI.GetIndexerById(ctx, int32(indexer.ID.ValueInt64())).Execute, helpers.ClearedFields(indexer, indexerFields), indexerTorznabResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(ctx, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorznabResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, indexerFields, helpers.Update, indexerTorznabResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+indexerTorznabResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	indexer.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &indexer)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *IndexerTorznabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete IndexerTorznab current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerTorznabResourceName, err))
 
@@ -328,10 +395,19 @@ func (r *IndexerTorznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerTorznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	indexerImporter(r.client, indexerTorznabImplementation).importState(ctx, r.auth, indexerTorznabResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+indexerTorznabResourceName+": "+req.ID)
 }
 
+func (r *IndexerTorznabResource) MoveState(ctx context.Context) []resource.StateMover {
+	return indexerMover(ctx, indexerTorznabImplementation, func(indexer *IndexerResourceModel) interface{} {
+		moved := IndexerTorznab{Timeouts: indexer.Timeouts}
+		moved.fromIndexer(&indexer.Indexer)
+
+		return &moved
+	})
+}
+
 func (i *IndexerTorznab) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
 	genericIndexer := i.toIndexer()
 	genericIndexer.write(ctx, indexer, diags)
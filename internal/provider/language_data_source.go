@@ -85,7 +85,7 @@ func (d *LanguageDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Get languages current value
-	response, _, err := d.client.LanguageAPI.ListLanguage(d.auth).Execute()
+	response, _, err := d.client.LanguageAPI.ListLanguage(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, languageDataSourceName, err))
 
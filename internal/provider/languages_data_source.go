@@ -78,7 +78,7 @@ func (d *LanguagesDataSource) Configure(ctx context.Context, req datasource.Conf
 
 func (d *LanguagesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get languages current value
-	response, _, err := d.client.LanguageAPI.ListLanguage(d.auth).Execute()
+	response, _, err := d.client.LanguageAPI.ListLanguage(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, languagesDataSourceName, err))
 
@@ -123,7 +123,7 @@ func (d *MediaManagementDataSource) Configure(ctx context.Context, req datasourc
 
 func (d *MediaManagementDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get indexer config current value
-	response, _, err := d.client.MediaManagementConfigAPI.GetMediaManagementConfig(d.auth).Execute()
+	response, _, err := d.client.MediaManagementConfigAPI.GetMediaManagementConfig(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, mediaManagementDataSourceName, err))
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +23,7 @@ const mediaManagementResourceName = "media_management"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &MediaManagementResource{}
+	_ resource.ResourceWithModifyPlan  = &MediaManagementResource{}
 	_ resource.ResourceWithImportState = &MediaManagementResource{}
 )
 
@@ -31,8 +33,9 @@ func NewMediaManagementResource() resource.Resource {
 
 // MediaManagementResource defines the media management implementation.
 type MediaManagementResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // MediaManagement describes the media management data model.
@@ -58,11 +61,17 @@ type MediaManagement struct {
 	HardlinksCopy             types.Bool   `tfsdk:"hardlinks_copy"`
 }
 
+// MediaManagementResourceModel describes the media management resource data model, including timeouts.
+type MediaManagementResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	MediaManagement
+}
+
 func (r *MediaManagementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + mediaManagementResourceName
 }
 
-func (r *MediaManagementResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MediaManagementResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->\nMedia Management resource.\nFor more information refer to [Naming](https://wiki.servarr.com/sonarr/settings#file-management) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -158,6 +167,13 @@ func (r *MediaManagementResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -165,12 +181,17 @@ func (r *MediaManagementResource) Configure(ctx context.Context, req resource.Co
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *MediaManagementResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, mediaManagementResourceName, req.State, resp.Plan)...)
+}
+
 func (r *MediaManagementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var management *MediaManagement
+	var management *MediaManagementResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &management)...)
 
@@ -178,14 +199,17 @@ func (r *MediaManagementResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, management.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Create resource
 	request := management.read()
 	request.SetId(1)
 
 	// Create new MediaManagement
-	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(r.auth, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
+	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(ctx, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, mediaManagementResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, mediaManagementResourceName, err)
 
 		return
 	}
@@ -198,7 +222,7 @@ func (r *MediaManagementResource) Create(ctx context.Context, req resource.Creat
 
 func (r *MediaManagementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var management *MediaManagement
+	var management *MediaManagementResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &management)...)
 
@@ -206,8 +230,11 @@ func (r *MediaManagementResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, management.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get mediamanagement current value
-	response, _, err := r.client.MediaManagementConfigAPI.GetMediaManagementConfig(r.auth).Execute()
+	response, _, err := r.client.MediaManagementConfigAPI.GetMediaManagementConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, mediaManagementResourceName, err))
 
@@ -222,7 +249,7 @@ func (r *MediaManagementResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *MediaManagementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var management *MediaManagement
+	var management *MediaManagementResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &management)...)
 
@@ -230,13 +257,23 @@ func (r *MediaManagementResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, management.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
 	request := management.read()
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.MediaManagementConfigAPI.GetMediaManagementConfig(ctx).Execute, nil, mediaManagementResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update MediaManagement
-	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(r.auth, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
+	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(ctx, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, mediaManagementResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, mediaManagementResourceName, err)
 
 		return
 	}
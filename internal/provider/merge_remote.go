@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"net/http"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// mergeRemote fetches the current remote object and overlays the update request on it,
+// so that settings not managed by the provider survive the update. Cleared provider fields are reset.
+func mergeRemote[T any](request *T, get func() (*T, *http.Response, error), cleared []string, resourceName string, diags *diag.Diagnostics) *T {
+	remote, _, err := get()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, resourceName, err))
+
+		return request
+	}
+
+	merged, err := helpers.MergeRemote(remote, request, cleared)
+	if err != nil {
+		diags.AddError(helpers.ResourceError, "Unable to merge "+resourceName+" with its remote value, got error: "+err.Error())
+
+		return request
+	}
+
+	return merged
+}
@@ -106,7 +106,7 @@ func (d *MetadataDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 	// Get metadata current value
-	response, _, err := d.client.MetadataAPI.ListMetadata(d.auth).Execute()
+	response, _, err := d.client.MetadataAPI.ListMetadata(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataDataSourceName, err))
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &MetadataKodiResource{}
 	_ resource.ResourceWithImportState = &MetadataKodiResource{}
+	_ resource.ResourceWithMoveState   = &MetadataKodiResource{}
+	_ resource.ResourceWithModifyPlan  = &MetadataKodiResource{}
 )
 
 func NewMetadataKodiResource() resource.Resource {
@@ -34,22 +37,25 @@ func NewMetadataKodiResource() resource.Resource {
 
 // MetadataKodiResource defines the Kodi metadata implementation.
 type MetadataKodiResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // MetadataKodi describes the Kodi metadata data model.
 type MetadataKodi struct {
-	Tags              types.Set    `tfsdk:"tags"`
-	Name              types.String `tfsdk:"name"`
-	ID                types.Int64  `tfsdk:"id"`
-	Enable            types.Bool   `tfsdk:"enable"`
-	SeriesMetadata    types.Bool   `tfsdk:"series_metadata"`
-	SeriesMetadataURL types.Bool   `tfsdk:"series_metadata_url"`
-	SeriesImages      types.Bool   `tfsdk:"series_images"`
-	SeasonImages      types.Bool   `tfsdk:"season_images"`
-	EpisodeMetadata   types.Bool   `tfsdk:"episode_metadata"`
-	EpisodeImages     types.Bool   `tfsdk:"episode_images"`
+	Tags              types.Set      `tfsdk:"tags"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	Name              types.String   `tfsdk:"name"`
+	ID                types.Int64    `tfsdk:"id"`
+	Enable            types.Bool     `tfsdk:"enable"`
+	SeriesMetadata    types.Bool     `tfsdk:"series_metadata"`
+	SeriesMetadataURL types.Bool     `tfsdk:"series_metadata_url"`
+	SeriesImages      types.Bool     `tfsdk:"series_images"`
+	SeasonImages      types.Bool     `tfsdk:"season_images"`
+	EpisodeMetadata   types.Bool     `tfsdk:"episode_metadata"`
+	EpisodeImages     types.Bool     `tfsdk:"episode_images"`
 }
 
 func (m MetadataKodi) toMetadata() *Metadata {
@@ -86,7 +92,7 @@ func (r *MetadataKodiResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_" + metadataKodiResourceName
 }
 
-func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MetadataKodiResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Kodi resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/sonarr/settings#metadata) and [KODI](https://wiki.servarr.com/sonarr/supported#xbmcmetadata).",
 		Attributes: map[string]schema.Attribute{
@@ -138,6 +144,14 @@ func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaReques
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -145,9 +159,17 @@ func (r *MetadataKodiResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *MetadataKodiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, metadataKodiResourceName, req.State, resp.Plan)...)
+}
+
 func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var metadata *MetadataKodi
@@ -158,12 +180,17 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataKodiResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, metadataFields, helpers.Create, metadataKodiResourceName, err)
 
 		return
 	}
@@ -172,6 +199,7 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -184,9 +212,19 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get MetadataKodi current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(metadataKodiResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataKodiResourceName, err))
 
 		return
@@ -196,6 +234,7 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 	// Map response body to resource schema attribute
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -208,12 +247,24 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute, helpers.ClearedFields(metadata, metadataFields), metadataKodiResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataKodiResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, metadataFields, helpers.Update, metadataKodiResourceName, err)
 
 		return
 	}
@@ -222,19 +273,27 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete MetadataKodi current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataKodiResourceName, err))
 
@@ -246,10 +305,19 @@ func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MetadataKodiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	metadataImporter(r.client, metadataKodiImplementation).importState(ctx, r.auth, metadataKodiResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+metadataKodiResourceName+": "+req.ID)
 }
 
+func (r *MetadataKodiResource) MoveState(ctx context.Context) []resource.StateMover {
+	return metadataMover(ctx, metadataKodiImplementation, func(metadata *MetadataResourceModel) interface{} {
+		moved := MetadataKodi{Timeouts: metadata.Timeouts}
+		moved.fromMetadata(&metadata.Metadata)
+
+		return &moved
+	})
+}
+
 func (m *MetadataKodi) write(ctx context.Context, metadata *sonarr.MetadataResource, diags *diag.Diagnostics) {
 	genericMetadata := m.toMetadata()
 	genericMetadata.write(ctx, metadata, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +24,7 @@ const metadataResourceName = "metadata"
 var (
 	_ resource.Resource                = &MetadataResource{}
 	_ resource.ResourceWithImportState = &MetadataResource{}
+	_ resource.ResourceWithModifyPlan  = &MetadataResource{}
 )
 
 var metadataFields = helpers.Fields{
@@ -35,8 +37,10 @@ func NewMetadataResource() resource.Resource {
 
 // MetadataResource defines the metadata implementation.
 type MetadataResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // Metadata describes the metadata data model.
@@ -55,6 +59,12 @@ type Metadata struct {
 	EpisodeImages     types.Bool   `tfsdk:"episode_images"`
 }
 
+// MetadataResourceModel describes the metadata resource data model, including timeouts.
+type MetadataResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Metadata
+}
+
 func (m Metadata) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -77,7 +87,7 @@ func (r *MetadataResource) Metadata(_ context.Context, req resource.MetadataRequ
 	resp.TypeName = req.ProviderTypeName + "_" + metadataResourceName
 }
 
-func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MetadataResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nGeneric Metadata resource. When possible use a specific resource instead.\nFor more information refer to [Metadata](https://wiki.servarr.com/sonarr/settings#metadata) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -143,6 +153,14 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -150,12 +168,20 @@ func (r *MetadataResource) Configure(ctx context.Context, req resource.Configure
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *MetadataResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, metadataResourceName, req.State, resp.Plan)...)
+}
+
 func (r *MetadataResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var metadata *Metadata
+	var metadata *MetadataResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
 
@@ -163,12 +189,17 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, metadataFields, helpers.Create, metadataResourceName, err)
 
 		return
 	}
@@ -176,15 +207,16 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	tflog.Trace(ctx, "created "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	state := MetadataResourceModel{Timeouts: metadata.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var metadata *Metadata
+	var metadata *MetadataResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &metadata)...)
 
@@ -192,9 +224,19 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get Metadata current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(metadataResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataResourceName, err))
 
 		return
@@ -203,15 +245,16 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	tflog.Trace(ctx, "read "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	state := MetadataResourceModel{Timeouts: metadata.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var metadata *Metadata
+	var metadata *MetadataResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
 
@@ -219,12 +262,24 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute, helpers.ClearedFields(metadata, metadataFields), metadataResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, metadataFields, helpers.Update, metadataResourceName, err)
 
 		return
 	}
@@ -232,23 +287,31 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	tflog.Trace(ctx, "updated "+metadataResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct.
 	// this is needed because of many empty fields are unknown in both plan and read
-	var state Metadata
+	state := MetadataResourceModel{Timeouts: metadata.Timeouts}
 
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete Metadata current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataResourceName, err))
 
@@ -260,7 +323,7 @@ func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *MetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	metadataImporter(r.client, "").importState(ctx, r.auth, metadataResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+metadataResourceName+": "+req.ID)
 }
 
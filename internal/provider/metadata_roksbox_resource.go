@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &MetadataRoksboxResource{}
 	_ resource.ResourceWithImportState = &MetadataRoksboxResource{}
+	_ resource.ResourceWithMoveState   = &MetadataRoksboxResource{}
+	_ resource.ResourceWithModifyPlan  = &MetadataRoksboxResource{}
 )
 
 func NewMetadataRoksboxResource() resource.Resource {
@@ -34,20 +37,23 @@ func NewMetadataRoksboxResource() resource.Resource {
 
 // MetadataRoksboxResource defines the Roksbox metadata implementation.
 type MetadataRoksboxResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // MetadataRoksbox describes the Roksbox metadata data model.
 type MetadataRoksbox struct {
-	Tags            types.Set    `tfsdk:"tags"`
-	Name            types.String `tfsdk:"name"`
-	ID              types.Int64  `tfsdk:"id"`
-	Enable          types.Bool   `tfsdk:"enable"`
-	SeriesImages    types.Bool   `tfsdk:"series_images"`
-	SeasonImages    types.Bool   `tfsdk:"season_images"`
-	EpisodeMetadata types.Bool   `tfsdk:"episode_metadata"`
-	EpisodeImages   types.Bool   `tfsdk:"episode_images"`
+	Tags            types.Set      `tfsdk:"tags"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	Name            types.String   `tfsdk:"name"`
+	ID              types.Int64    `tfsdk:"id"`
+	Enable          types.Bool     `tfsdk:"enable"`
+	SeriesImages    types.Bool     `tfsdk:"series_images"`
+	SeasonImages    types.Bool     `tfsdk:"season_images"`
+	EpisodeMetadata types.Bool     `tfsdk:"episode_metadata"`
+	EpisodeImages   types.Bool     `tfsdk:"episode_images"`
 }
 
 func (m MetadataRoksbox) toMetadata() *Metadata {
@@ -80,7 +86,7 @@ func (r *MetadataRoksboxResource) Metadata(_ context.Context, req resource.Metad
 	resp.TypeName = req.ProviderTypeName + "_" + metadataRoksboxResourceName
 }
 
-func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MetadataRoksboxResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Roksbox resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/sonarr/settings#metadata) and [ROKSBOX](https://wiki.servarr.com/sonarr/supported#roksboxmetadata).",
 		Attributes: map[string]schema.Attribute{
@@ -124,6 +130,14 @@ func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -131,9 +145,17 @@ func (r *MetadataRoksboxResource) Configure(ctx context.Context, req resource.Co
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *MetadataRoksboxResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, metadataRoksboxResourceName, req.State, resp.Plan)...)
+}
+
 func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var metadata *MetadataRoksbox
@@ -144,12 +166,17 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataRoksboxResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, metadataFields, helpers.Create, metadataRoksboxResourceName, err)
 
 		return
 	}
@@ -158,6 +185,7 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -170,9 +198,19 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get MetadataRoksbox current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(metadataRoksboxResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataRoksboxResourceName, err))
 
 		return
@@ -182,6 +220,7 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 	// Map response body to resource schema attribute
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -194,12 +233,24 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute, helpers.ClearedFields(metadata, metadataFields), metadataRoksboxResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataRoksboxResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, metadataFields, helpers.Update, metadataRoksboxResourceName, err)
 
 		return
 	}
@@ -208,19 +259,27 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete MetadataRoksbox current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataRoksboxResourceName, err))
 
@@ -232,10 +291,19 @@ func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *MetadataRoksboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	metadataImporter(r.client, metadataRoksboxImplementation).importState(ctx, r.auth, metadataRoksboxResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+metadataRoksboxResourceName+": "+req.ID)
 }
 
+func (r *MetadataRoksboxResource) MoveState(ctx context.Context) []resource.StateMover {
+	return metadataMover(ctx, metadataRoksboxImplementation, func(metadata *MetadataResourceModel) interface{} {
+		moved := MetadataRoksbox{Timeouts: metadata.Timeouts}
+		moved.fromMetadata(&metadata.Metadata)
+
+		return &moved
+	})
+}
+
 func (m *MetadataRoksbox) write(ctx context.Context, metadata *sonarr.MetadataResource, diags *diag.Diagnostics) {
 	genericMetadata := m.toMetadata()
 	genericMetadata.write(ctx, metadata, diags)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,8 @@ const (
 var (
 	_ resource.Resource                = &MetadataWdtvResource{}
 	_ resource.ResourceWithImportState = &MetadataWdtvResource{}
+	_ resource.ResourceWithMoveState   = &MetadataWdtvResource{}
+	_ resource.ResourceWithModifyPlan  = &MetadataWdtvResource{}
 )
 
 func NewMetadataWdtvResource() resource.Resource {
@@ -34,20 +37,23 @@ func NewMetadataWdtvResource() resource.Resource {
 
 // MetadataWdtvResource defines the Wdtv metadata implementation.
 type MetadataWdtvResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // MetadataWdtv describes the Wdtv metadata data model.
 type MetadataWdtv struct {
-	Tags            types.Set    `tfsdk:"tags"`
-	Name            types.String `tfsdk:"name"`
-	ID              types.Int64  `tfsdk:"id"`
-	Enable          types.Bool   `tfsdk:"enable"`
-	SeriesImages    types.Bool   `tfsdk:"series_images"`
-	SeasonImages    types.Bool   `tfsdk:"season_images"`
-	EpisodeMetadata types.Bool   `tfsdk:"episode_metadata"`
-	EpisodeImages   types.Bool   `tfsdk:"episode_images"`
+	Tags            types.Set      `tfsdk:"tags"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	Name            types.String   `tfsdk:"name"`
+	ID              types.Int64    `tfsdk:"id"`
+	Enable          types.Bool     `tfsdk:"enable"`
+	SeriesImages    types.Bool     `tfsdk:"series_images"`
+	SeasonImages    types.Bool     `tfsdk:"season_images"`
+	EpisodeMetadata types.Bool     `tfsdk:"episode_metadata"`
+	EpisodeImages   types.Bool     `tfsdk:"episode_images"`
 }
 
 func (m MetadataWdtv) toMetadata() *Metadata {
@@ -80,7 +86,7 @@ func (r *MetadataWdtvResource) Metadata(_ context.Context, req resource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_" + metadataWdtvResourceName
 }
 
-func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MetadataWdtvResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Wdtv resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/sonarr/settings#metadata) and [WDTV](https://wiki.servarr.com/sonarr/supported#wdtvmetadata).",
 		Attributes: map[string]schema.Attribute{
@@ -124,6 +130,14 @@ func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaReques
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -131,9 +145,17 @@ func (r *MetadataWdtvResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *MetadataWdtvResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, metadataWdtvResourceName, req.State, resp.Plan)...)
+}
+
 func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var metadata *MetadataWdtv
@@ -144,12 +166,17 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.MetadataAPI.CreateMetadata(ctx).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataWdtvResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, metadataFields, helpers.Create, metadataWdtvResourceName, err)
 
 		return
 	}
@@ -158,6 +185,7 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -170,9 +198,19 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get MetadataWdtv current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(metadataWdtvResourceName, metadata.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataWdtvResourceName, err))
 
 		return
@@ -182,6 +220,7 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 	// Map response body to resource schema attribute
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -194,12 +233,24 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, metadata.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.MetadataAPI.GetMetadataById(ctx, int32(metadata.ID.ValueInt64())).Execute, helpers.ClearedFields(metadata, metadataFields), metadataWdtvResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(ctx, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataWdtvResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, metadataFields, helpers.Update, metadataWdtvResourceName, err)
 
 		return
 	}
@@ -208,19 +259,27 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 	// Generate resource state struct
 	metadata.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete MetadataWdtv current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataWdtvResourceName, err))
 
@@ -232,10 +291,19 @@ func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MetadataWdtvResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	metadataImporter(r.client, metadataWdtvImplementation).importState(ctx, r.auth, metadataWdtvResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+metadataWdtvResourceName+": "+req.ID)
 }
 
+func (r *MetadataWdtvResource) MoveState(ctx context.Context) []resource.StateMover {
+	return metadataMover(ctx, metadataWdtvImplementation, func(metadata *MetadataResourceModel) interface{} {
+		moved := MetadataWdtv{Timeouts: metadata.Timeouts}
+		moved.fromMetadata(&metadata.Metadata)
+
+		return &moved
+	})
+}
+
 func (m *MetadataWdtv) write(ctx context.Context, metadata *sonarr.MetadataResource, diags *diag.Diagnostics) {
 	genericMetadata := m.toMetadata()
 	genericMetadata.write(ctx, metadata, diags)
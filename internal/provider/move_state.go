@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// genericMover moves the state of a generic resource (e.g. `sonarr_download_client`) to a typed one,
+// using move to convert the overlapping attributes. The source implementation must match the typed resource.
+func genericMover[S any](
+	ctx context.Context,
+	source resource.Resource,
+	implementation string,
+	implementationOf func(*S) types.String,
+	move func(*S) interface{},
+) resource.StateMover {
+	sourceSchema := resource.SchemaResponse{}
+	source.Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+	sourceTypeName := resourceTypeName(ctx, source)
+
+	return resource.StateMover{
+		SourceSchema: &sourceSchema.Schema,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			// The provider address is not checked, since it differs for mirrors, forks and development overrides.
+			if req.SourceTypeName != sourceTypeName {
+				return
+			}
+
+			var state S
+
+			resp.Diagnostics.Append(req.SourceState.Get(ctx, &state)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if sourceImplementation := implementationOf(&state).ValueString(); sourceImplementation != implementation {
+				resp.Diagnostics.AddError(
+					helpers.UnexpectedMoveSource,
+					fmt.Sprintf("Expected %s with implementation %s, got: %s", sourceTypeName, implementation, sourceImplementation),
+				)
+
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.Set(ctx, move(&state))...)
+
+			// Keep the hashes used to detect the drift of secrets.
+			if req.SourcePrivate == nil || resp.TargetPrivate == nil {
+				return
+			}
+
+			hashes, diags := req.SourcePrivate.GetKey(ctx, secretHashesKey)
+			resp.Diagnostics.Append(diags...)
+
+			if len(hashes) != 0 {
+				resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, secretHashesKey, hashes)...)
+			}
+		},
+	}
+}
+
+// downloadClientMover moves `sonarr_download_client` to the typed resource of the implementation.
+func downloadClientMover(ctx context.Context, implementation string, move func(*DownloadClientResourceModel) interface{}) []resource.StateMover {
+	return []resource.StateMover{genericMover(ctx, NewDownloadClientResource(), implementation, func(s *DownloadClientResourceModel) types.String {
+		return s.Implementation
+	}, move)}
+}
+
+// importListMover moves `sonarr_import_list` to the typed resource of the implementation.
+func importListMover(ctx context.Context, implementation string, move func(*ImportListResourceModel) interface{}) []resource.StateMover {
+	return []resource.StateMover{genericMover(ctx, NewImportListResource(), implementation, func(s *ImportListResourceModel) types.String {
+		return s.Implementation
+	}, move)}
+}
+
+// indexerMover moves `sonarr_indexer` to the typed resource of the implementation.
+func indexerMover(ctx context.Context, implementation string, move func(*IndexerResourceModel) interface{}) []resource.StateMover {
+	return []resource.StateMover{genericMover(ctx, NewIndexerResource(), implementation, func(s *IndexerResourceModel) types.String {
+		return s.Implementation
+	}, move)}
+}
+
+// metadataMover moves `sonarr_metadata` to the typed resource of the implementation.
+func metadataMover(ctx context.Context, implementation string, move func(*MetadataResourceModel) interface{}) []resource.StateMover {
+	return []resource.StateMover{genericMover(ctx, NewMetadataResource(), implementation, func(s *MetadataResourceModel) types.String {
+		return s.Implementation
+	}, move)}
+}
+
+// notificationMover moves `sonarr_notification` to the typed resource of the implementation.
+func notificationMover(ctx context.Context, implementation string, move func(*NotificationResourceModel) interface{}) []resource.StateMover {
+	return []resource.StateMover{genericMover(ctx, NewNotificationResource(), implementation, func(s *NotificationResourceModel) types.String {
+		return s.Implementation
+	}, move)}
+}
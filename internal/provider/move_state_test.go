@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadClientMover(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	movers := (&DownloadClientTransmissionResource{}).MoveState(ctx)
+	targetSchema := resource.SchemaResponse{}
+	NewDownloadClientTransmissionResource().Schema(ctx, resource.SchemaRequest{}, &targetSchema)
+
+	tests := map[string]struct {
+		typeName       string
+		address        string
+		implementation string
+		err            bool
+		moved          bool
+	}{
+		"moved": {
+			typeName:       "sonarr_download_client",
+			address:        "registry.terraform.io/devopsarr/sonarr",
+			implementation: downloadClientTransmissionImplementation,
+			moved:          true,
+		},
+		"wrong implementation": {
+			typeName:       "sonarr_download_client",
+			address:        "registry.terraform.io/devopsarr/sonarr",
+			implementation: downloadClientDelugeImplementation,
+			err:            true,
+		},
+		"other resource": {
+			typeName:       "sonarr_indexer",
+			address:        "registry.terraform.io/devopsarr/sonarr",
+			implementation: downloadClientTransmissionImplementation,
+		},
+		"mirrored provider": {
+			typeName:       "sonarr_download_client",
+			address:        "terraform.example.com/mirror/sonarr",
+			implementation: downloadClientTransmissionImplementation,
+			moved:          true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sourceState := tfsdk.State{
+				Schema: movers[0].SourceSchema,
+				Raw:    tftypes.NewValue(movers[0].SourceSchema.Type().TerraformType(ctx), nil),
+			}
+			sourceState.SetAttribute(ctx, path.Root("id"), int64(1))
+			sourceState.SetAttribute(ctx, path.Root("name"), "Transmission")
+			sourceState.SetAttribute(ctx, path.Root("implementation"), test.implementation)
+			sourceState.SetAttribute(ctx, path.Root("host"), "transmission")
+
+			req := resource.MoveStateRequest{
+				SourceProviderAddress: test.address,
+				SourceTypeName:        test.typeName,
+				SourceState:           &sourceState,
+			}
+			resp := resource.MoveStateResponse{
+				TargetState: tfsdk.State{
+					Schema: targetSchema.Schema,
+					Raw:    tftypes.NewValue(targetSchema.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+
+			movers[0].StateMover(ctx, req, &resp)
+			assert.Equal(t, test.err, resp.Diagnostics.HasError())
+			assert.Equal(t, test.moved, !resp.TargetState.Raw.IsNull())
+
+			if test.moved {
+				var host types.String
+
+				resp.TargetState.GetAttribute(ctx, path.Root("host"), &host)
+				assert.Equal(t, "transmission", host.ValueString())
+			}
+		})
+	}
+}
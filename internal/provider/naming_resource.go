@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +23,7 @@ const namingResourceName = "naming"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &NamingResource{}
+	_ resource.ResourceWithModifyPlan  = &NamingResource{}
 	_ resource.ResourceWithImportState = &NamingResource{}
 )
 
@@ -31,8 +33,9 @@ func NewNamingResource() resource.Resource {
 
 // NamingResource defines the naming implementation.
 type NamingResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // Naming describes the naming data model.
@@ -50,11 +53,17 @@ type Naming struct {
 	ReplaceIllegalCharacters types.Bool   `tfsdk:"replace_illegal_characters"`
 }
 
+// NamingResourceModel describes the naming resource data model, including timeouts.
+type NamingResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Naming
+}
+
 func (r *NamingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + namingResourceName
 }
 
-func (r *NamingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NamingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->\nNaming resource.\nFor more information refer to [Naming](https://wiki.servarr.com/sonarr/settings#community-naming-suggestions) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -112,6 +121,13 @@ func (r *NamingResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -119,12 +135,17 @@ func (r *NamingResource) Configure(ctx context.Context, req resource.ConfigureRe
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *NamingResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, namingResourceName, req.State, resp.Plan)...)
+}
+
 func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var naming *Naming
+	var naming *NamingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &naming)...)
 
@@ -132,8 +153,11 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, naming.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Init call if we remove this it the very first update on a brand new instance will fail
-	if _, _, err := r.client.NamingConfigAPI.GetNamingConfig(r.auth).Execute(); err != nil {
+	if _, _, err := r.client.NamingConfigAPI.GetNamingConfig(ctx).Execute(); err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError("init", namingResourceName, err))
 
 		return
@@ -144,9 +168,9 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 	request.SetId(1)
 
 	// Create new Naming
-	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(r.auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
+	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(ctx, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, namingResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, namingResourceName, err)
 
 		return
 	}
@@ -159,7 +183,7 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *NamingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var naming *Naming
+	var naming *NamingResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &naming)...)
 
@@ -167,8 +191,11 @@ func (r *NamingResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, naming.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get naming current value
-	response, _, err := r.client.NamingConfigAPI.GetNamingConfig(r.auth).Execute()
+	response, _, err := r.client.NamingConfigAPI.GetNamingConfig(ctx).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingResourceName, err))
 
@@ -183,7 +210,7 @@ func (r *NamingResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 func (r *NamingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var naming *Naming
+	var naming *NamingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &naming)...)
 
@@ -191,13 +218,23 @@ func (r *NamingResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, naming.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
 	request := naming.read()
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.NamingConfigAPI.GetNamingConfig(ctx).Execute, nil, namingResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Naming
-	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(r.auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
+	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(ctx, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, namingResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, namingResourceName, err)
 
 		return
 	}
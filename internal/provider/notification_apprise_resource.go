@@ -6,6 +6,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +30,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
 	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.ResourceWithMoveState   = &NotificationAppriseResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationAppriseResource{}
 )
 
 func NewNotificationAppriseResource() resource.Resource {
@@ -36,35 +40,40 @@ func NewNotificationAppriseResource() resource.Resource {
 
 // NotificationAppriseResource defines the notification implementation.
 type NotificationAppriseResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	version           *version.Version
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // NotificationApprise describes the notification data model.
 type NotificationApprise struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	FieldTags                     types.Set    `tfsdk:"field_tags"`
-	Name                          types.String `tfsdk:"name"`
-	StatelessURLs                 types.String `tfsdk:"stateless_urls"`
-	ServerURL                     types.String `tfsdk:"server_url"`
-	AuthUsername                  types.String `tfsdk:"auth_username"`
-	AuthPassword                  types.String `tfsdk:"auth_password"`
-	ConfigurationKey              types.String `tfsdk:"configuration_key"`
-	NotificationType              types.Int64  `tfsdk:"notification_type"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
-	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	Tags                          types.Set      `tfsdk:"tags"`
+	FieldTags                     types.Set      `tfsdk:"field_tags"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+	Name                          types.String   `tfsdk:"name"`
+	StatelessURLs                 types.String   `tfsdk:"stateless_urls"`
+	ServerURL                     types.String   `tfsdk:"server_url"`
+	AuthUsername                  types.String   `tfsdk:"auth_username"`
+	AuthPassword                  types.String   `tfsdk:"auth_password"`
+	ConfigurationKey              types.String   `tfsdk:"configuration_key"`
+	NotificationType              types.Int64    `tfsdk:"notification_type"`
+	ID                            types.Int64    `tfsdk:"id"`
+	OnGrab                        types.Bool     `tfsdk:"on_grab"`
+	OnEpisodeFileDeleteForUpgrade types.Bool     `tfsdk:"on_episode_file_delete_for_upgrade"`
+	OnEpisodeFileDelete           types.Bool     `tfsdk:"on_episode_file_delete"`
+	IncludeHealthWarnings         types.Bool     `tfsdk:"include_health_warnings"`
+	OnApplicationUpdate           types.Bool     `tfsdk:"on_application_update"`
+	OnHealthIssue                 types.Bool     `tfsdk:"on_health_issue"`
+	OnHealthRestored              types.Bool     `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired   types.Bool     `tfsdk:"on_manual_interaction_required"`
+	OnSeriesAdd                   types.Bool     `tfsdk:"on_series_add"`
+	OnSeriesDelete                types.Bool     `tfsdk:"on_series_delete"`
+	OnUpgrade                     types.Bool     `tfsdk:"on_upgrade"`
+	OnDownload                    types.Bool     `tfsdk:"on_download"`
+	OnImportComplete              types.Bool     `tfsdk:"on_import_complete"`
 }
 
 func (n NotificationApprise) toNotification() *Notification {
@@ -127,7 +136,7 @@ func (r *NotificationAppriseResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_" + notificationAppriseResourceName
 }
 
-func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationAppriseResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Apprise resource.\nFor more information refer to [Notification](https://wiki.servarr.com/sonarr/settings#connect) and [Apprise](https://wiki.servarr.com/sonarr/supported#apprise).",
 		Attributes: map[string]schema.Attribute{
@@ -255,6 +264,14 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -262,9 +279,20 @@ func (r *NotificationAppriseResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.version = resourceServerVersion(req)
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *NotificationAppriseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkServerVersion(ctx, r.version, req.Config, notificationAppriseResourceName, notificationVersionRequirements)...)
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, notificationAppriseResourceName, req.State, resp.Plan)...)
+}
+
 func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationApprise
@@ -275,20 +303,27 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Create, notificationAppriseResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -301,18 +336,33 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get NotificationApprise current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(notificationAppriseResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationAppriseResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, notification.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, notificationSecretTest(ctx, r.client, *response), notificationAppriseResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -325,33 +375,54 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute, helpers.ClearedFields(notification, notificationFields), notificationAppriseResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Update, notificationAppriseResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+notificationAppriseResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete NotificationApprise current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationAppriseResourceName, err))
 
@@ -363,10 +434,19 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationAppriseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	notificationImporter(r.client, notificationAppriseImplementation).importState(ctx, r.auth, notificationAppriseResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+notificationAppriseResourceName+": "+req.ID)
 }
 
+func (r *NotificationAppriseResource) MoveState(ctx context.Context) []resource.StateMover {
+	return notificationMover(ctx, notificationAppriseImplementation, func(notification *NotificationResourceModel) interface{} {
+		moved := NotificationApprise{Timeouts: notification.Timeouts}
+		moved.fromNotification(&notification.Notification)
+
+		return &moved
+	})
+}
+
 func (n *NotificationApprise) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
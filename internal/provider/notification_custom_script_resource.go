@@ -6,6 +6,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationCustomScriptResource{}
 	_ resource.ResourceWithImportState = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithMoveState   = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationCustomScriptResource{}
 )
 
 func NewNotificationCustomScriptResource() resource.Resource {
@@ -34,31 +38,35 @@ func NewNotificationCustomScriptResource() resource.Resource {
 
 // NotificationCustomScriptResource defines the notification implementation.
 type NotificationCustomScriptResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	version     *version.Version
+	defaultTags []int64
+	readOnly    bool
 }
 
 // NotificationCustomScript describes the notification data model.
 type NotificationCustomScript struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Arguments                     types.String `tfsdk:"arguments"`
-	Path                          types.String `tfsdk:"path"`
-	Name                          types.String `tfsdk:"name"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
-	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	Tags                          types.Set      `tfsdk:"tags"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+	Arguments                     types.String   `tfsdk:"arguments"`
+	Path                          types.String   `tfsdk:"path"`
+	Name                          types.String   `tfsdk:"name"`
+	ID                            types.Int64    `tfsdk:"id"`
+	OnGrab                        types.Bool     `tfsdk:"on_grab"`
+	OnEpisodeFileDeleteForUpgrade types.Bool     `tfsdk:"on_episode_file_delete_for_upgrade"`
+	OnEpisodeFileDelete           types.Bool     `tfsdk:"on_episode_file_delete"`
+	IncludeHealthWarnings         types.Bool     `tfsdk:"include_health_warnings"`
+	OnApplicationUpdate           types.Bool     `tfsdk:"on_application_update"`
+	OnHealthIssue                 types.Bool     `tfsdk:"on_health_issue"`
+	OnHealthRestored              types.Bool     `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired   types.Bool     `tfsdk:"on_manual_interaction_required"`
+	OnSeriesAdd                   types.Bool     `tfsdk:"on_series_add"`
+	OnSeriesDelete                types.Bool     `tfsdk:"on_series_delete"`
+	OnRename                      types.Bool     `tfsdk:"on_rename"`
+	OnUpgrade                     types.Bool     `tfsdk:"on_upgrade"`
+	OnDownload                    types.Bool     `tfsdk:"on_download"`
+	OnImportComplete              types.Bool     `tfsdk:"on_import_complete"`
 }
 
 func (n NotificationCustomScript) toNotification() *Notification {
@@ -113,7 +121,7 @@ func (r *NotificationCustomScriptResource) Metadata(_ context.Context, req resou
 	resp.TypeName = req.ProviderTypeName + "_" + notificationCustomScriptResourceName
 }
 
-func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationCustomScriptResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Custom Script resource.\nFor more information refer to [Notification](https://wiki.servarr.com/sonarr/settings#connect) and [Custom Script](https://wiki.servarr.com/sonarr/supported#customscript).",
 		Attributes: map[string]schema.Attribute{
@@ -215,6 +223,14 @@ func (r *NotificationCustomScriptResource) Schema(_ context.Context, _ resource.
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -222,9 +238,19 @@ func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req re
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.version = resourceServerVersion(req)
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *NotificationCustomScriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkServerVersion(ctx, r.version, req.Config, notificationCustomScriptResourceName, notificationVersionRequirements)...)
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, notificationCustomScriptResourceName, req.State, resp.Plan)...)
+}
+
 func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationCustomScript
@@ -235,12 +261,17 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Create, notificationCustomScriptResourceName, err)
 
 		return
 	}
@@ -249,6 +280,7 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -261,9 +293,19 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get NotificationCustomScript current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(notificationCustomScriptResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationCustomScriptResourceName, err))
 
 		return
@@ -273,6 +315,7 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -285,12 +328,24 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute, helpers.ClearedFields(notification, notificationFields), notificationCustomScriptResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Update, notificationCustomScriptResourceName, err)
 
 		return
 	}
@@ -299,19 +354,27 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete NotificationCustomScript current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationCustomScriptResourceName, err))
 
@@ -323,10 +386,19 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	notificationImporter(r.client, notificationCustomScriptImplementation).importState(ctx, r.auth, notificationCustomScriptResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+notificationCustomScriptResourceName+": "+req.ID)
 }
 
+func (r *NotificationCustomScriptResource) MoveState(ctx context.Context) []resource.StateMover {
+	return notificationMover(ctx, notificationCustomScriptImplementation, func(notification *NotificationResourceModel) interface{} {
+		moved := NotificationCustomScript{Timeouts: notification.Timeouts}
+		moved.fromNotification(&notification.Notification)
+
+		return &moved
+	})
+}
+
 func (n *NotificationCustomScript) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
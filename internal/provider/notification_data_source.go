@@ -436,7 +436,7 @@ func (d *NotificationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get notification current value
-	response, _, err := d.client.NotificationAPI.ListNotification(d.auth).Execute()
+	response, _, err := d.client.NotificationAPI.ListNotification(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDataSourceName, err))
 
@@ -6,6 +6,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationDiscordResource{}
 	_ resource.ResourceWithImportState = &NotificationDiscordResource{}
+	_ resource.ResourceWithMoveState   = &NotificationDiscordResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationDiscordResource{}
 )
 
 func NewNotificationDiscordResource() resource.Resource {
@@ -34,35 +38,39 @@ func NewNotificationDiscordResource() resource.Resource {
 
 // NotificationDiscordResource defines the notification implementation.
 type NotificationDiscordResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	version     *version.Version
+	defaultTags []int64
+	readOnly    bool
 }
 
 // NotificationDiscord describes the notification data model.
 type NotificationDiscord struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	ImportFields                  types.Set    `tfsdk:"import_fields"`
-	GrabFields                    types.Set    `tfsdk:"grab_fields"`
-	WebHookURL                    types.String `tfsdk:"web_hook_url"`
-	Name                          types.String `tfsdk:"name"`
-	Username                      types.String `tfsdk:"username"`
-	Avatar                        types.String `tfsdk:"avatar"`
-	Author                        types.String `tfsdk:"author"`
-	ID                            types.Int64  `tfsdk:"id"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
-	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	Tags                          types.Set      `tfsdk:"tags"`
+	ImportFields                  types.Set      `tfsdk:"import_fields"`
+	GrabFields                    types.Set      `tfsdk:"grab_fields"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+	WebHookURL                    types.String   `tfsdk:"web_hook_url"`
+	Name                          types.String   `tfsdk:"name"`
+	Username                      types.String   `tfsdk:"username"`
+	Avatar                        types.String   `tfsdk:"avatar"`
+	Author                        types.String   `tfsdk:"author"`
+	ID                            types.Int64    `tfsdk:"id"`
+	OnGrab                        types.Bool     `tfsdk:"on_grab"`
+	OnEpisodeFileDeleteForUpgrade types.Bool     `tfsdk:"on_episode_file_delete_for_upgrade"`
+	OnEpisodeFileDelete           types.Bool     `tfsdk:"on_episode_file_delete"`
+	IncludeHealthWarnings         types.Bool     `tfsdk:"include_health_warnings"`
+	OnApplicationUpdate           types.Bool     `tfsdk:"on_application_update"`
+	OnHealthIssue                 types.Bool     `tfsdk:"on_health_issue"`
+	OnHealthRestored              types.Bool     `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired   types.Bool     `tfsdk:"on_manual_interaction_required"`
+	OnSeriesAdd                   types.Bool     `tfsdk:"on_series_add"`
+	OnSeriesDelete                types.Bool     `tfsdk:"on_series_delete"`
+	OnRename                      types.Bool     `tfsdk:"on_rename"`
+	OnUpgrade                     types.Bool     `tfsdk:"on_upgrade"`
+	OnDownload                    types.Bool     `tfsdk:"on_download"`
+	OnImportComplete              types.Bool     `tfsdk:"on_import_complete"`
 }
 
 func (n NotificationDiscord) toNotification() *Notification {
@@ -125,7 +133,7 @@ func (r *NotificationDiscordResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_" + notificationDiscordResourceName
 }
 
-func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationDiscordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Discord resource.\nFor more information refer to [Notification](https://wiki.servarr.com/sonarr/settings#connect) and [Discord](https://wiki.servarr.com/sonarr/supported#discord).",
 		Attributes: map[string]schema.Attribute{
@@ -249,6 +257,14 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 				ElementType:         types.Int64Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -256,9 +272,19 @@ func (r *NotificationDiscordResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.version = resourceServerVersion(req)
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *NotificationDiscordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkServerVersion(ctx, r.version, req.Config, notificationDiscordResourceName, notificationVersionRequirements)...)
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, notificationDiscordResourceName, req.State, resp.Plan)...)
+}
+
 func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationDiscord
@@ -269,12 +295,17 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Create, notificationDiscordResourceName, err)
 
 		return
 	}
@@ -283,6 +314,7 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -295,9 +327,19 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get NotificationDiscord current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(notificationDiscordResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDiscordResourceName, err))
 
 		return
@@ -307,6 +349,7 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -319,12 +362,24 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute, helpers.ClearedFields(notification, notificationFields), notificationDiscordResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationDiscordResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Update, notificationDiscordResourceName, err)
 
 		return
 	}
@@ -333,19 +388,27 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete NotificationDiscord current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationDiscordResourceName, err))
 
@@ -357,10 +420,19 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	notificationImporter(r.client, notificationDiscordImplementation).importState(ctx, r.auth, notificationDiscordResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
 }
 
+func (r *NotificationDiscordResource) MoveState(ctx context.Context) []resource.StateMover {
+	return notificationMover(ctx, notificationDiscordImplementation, func(notification *NotificationResourceModel) interface{} {
+		moved := NotificationDiscord{Timeouts: notification.Timeouts}
+		moved.fromNotification(&notification.Notification)
+
+		return &moved
+	})
+}
+
 func (n *NotificationDiscord) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -6,6 +6,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +30,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmailResource{}
 	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.ResourceWithMoveState   = &NotificationEmailResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -36,37 +40,42 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	version           *version.Version
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // NotificationEmail describes the notification data model.
 type NotificationEmail struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	To                            types.Set    `tfsdk:"to"`
-	Cc                            types.Set    `tfsdk:"cc"`
-	Bcc                           types.Set    `tfsdk:"bcc"`
-	From                          types.String `tfsdk:"from"`
-	Server                        types.String `tfsdk:"server"`
-	Name                          types.String `tfsdk:"name"`
-	Username                      types.String `tfsdk:"username"`
-	Password                      types.String `tfsdk:"password"`
-	ID                            types.Int64  `tfsdk:"id"`
-	Port                          types.Int64  `tfsdk:"port"`
-	UseEncryption                 types.Int64  `tfsdk:"use_encryption"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
-	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	Tags                          types.Set      `tfsdk:"tags"`
+	To                            types.Set      `tfsdk:"to"`
+	Cc                            types.Set      `tfsdk:"cc"`
+	Bcc                           types.Set      `tfsdk:"bcc"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+	From                          types.String   `tfsdk:"from"`
+	Server                        types.String   `tfsdk:"server"`
+	Name                          types.String   `tfsdk:"name"`
+	Username                      types.String   `tfsdk:"username"`
+	Password                      types.String   `tfsdk:"password"`
+	ID                            types.Int64    `tfsdk:"id"`
+	Port                          types.Int64    `tfsdk:"port"`
+	UseEncryption                 types.Int64    `tfsdk:"use_encryption"`
+	OnGrab                        types.Bool     `tfsdk:"on_grab"`
+	OnEpisodeFileDeleteForUpgrade types.Bool     `tfsdk:"on_episode_file_delete_for_upgrade"`
+	OnEpisodeFileDelete           types.Bool     `tfsdk:"on_episode_file_delete"`
+	IncludeHealthWarnings         types.Bool     `tfsdk:"include_health_warnings"`
+	OnApplicationUpdate           types.Bool     `tfsdk:"on_application_update"`
+	OnHealthIssue                 types.Bool     `tfsdk:"on_health_issue"`
+	OnHealthRestored              types.Bool     `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired   types.Bool     `tfsdk:"on_manual_interaction_required"`
+	OnSeriesAdd                   types.Bool     `tfsdk:"on_series_add"`
+	OnSeriesDelete                types.Bool     `tfsdk:"on_series_delete"`
+	OnUpgrade                     types.Bool     `tfsdk:"on_upgrade"`
+	OnDownload                    types.Bool     `tfsdk:"on_download"`
+	OnImportComplete              types.Bool     `tfsdk:"on_import_complete"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
@@ -133,7 +142,7 @@ func (r *NotificationEmailResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_" + notificationEmailResourceName
 }
 
-func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationEmailResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Email resource.\nFor more information refer to [Notification](https://wiki.servarr.com/sonarr/settings#connect) and [Email](https://wiki.servarr.com/sonarr/supported#email).",
 		Attributes: map[string]schema.Attribute{
@@ -270,6 +279,14 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -277,9 +294,20 @@ func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.version = resourceServerVersion(req)
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *NotificationEmailResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkServerVersion(ctx, r.version, req.Config, notificationEmailResourceName, notificationVersionRequirements)...)
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, notificationEmailResourceName, req.State, resp.Plan)...)
+}
+
 func (r *NotificationEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmail
@@ -290,20 +318,27 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Create, notificationEmailResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -316,18 +351,33 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get NotificationEmail current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(notificationEmailResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, notification.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, notificationSecretTest(ctx, r.client, *response), notificationEmailResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationEmailResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -340,33 +390,54 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute, helpers.ClearedFields(notification, notificationFields), notificationEmailResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Update, notificationEmailResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+notificationEmailResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete NotificationEmail current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationEmailResourceName, err))
 
@@ -378,10 +449,19 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	notificationImporter(r.client, notificationEmailImplementation).importState(ctx, r.auth, notificationEmailResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
 
+func (r *NotificationEmailResource) MoveState(ctx context.Context) []resource.StateMover {
+	return notificationMover(ctx, notificationEmailImplementation, func(notification *NotificationResourceModel) interface{} {
+		moved := NotificationEmail{Timeouts: notification.Timeouts}
+		moved.fromNotification(&notification.Notification)
+
+		return &moved
+	})
+}
+
 func (n *NotificationEmail) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
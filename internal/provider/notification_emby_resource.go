@@ -6,6 +6,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +28,8 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmbyResource{}
 	_ resource.ResourceWithImportState = &NotificationEmbyResource{}
+	_ resource.ResourceWithMoveState   = &NotificationEmbyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmbyResource{}
 )
 
 func NewNotificationEmbyResource() resource.Resource {
@@ -34,34 +38,39 @@ func NewNotificationEmbyResource() resource.Resource {
 
 // NotificationEmbyResource defines the notification implementation.
 type NotificationEmbyResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client            *sonarr.APIClient
+	auth              context.Context
+	version           *version.Version
+	defaultTags       []int64
+	readOnly          bool
+	detectSecretDrift bool
 }
 
 // NotificationEmby describes the notification data model.
 type NotificationEmby struct {
-	Tags                          types.Set    `tfsdk:"tags"`
-	Host                          types.String `tfsdk:"host"`
-	APIKey                        types.String `tfsdk:"api_key"`
-	Name                          types.String `tfsdk:"name"`
-	ID                            types.Int64  `tfsdk:"id"`
-	Port                          types.Int64  `tfsdk:"port"`
-	UpdateLibrary                 types.Bool   `tfsdk:"update_library"`
-	Notify                        types.Bool   `tfsdk:"notify"`
-	UseSSL                        types.Bool   `tfsdk:"use_ssl"`
-	OnGrab                        types.Bool   `tfsdk:"on_grab"`
-	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
-	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
-	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
-	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
-	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
-	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
-	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
-	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
-	OnRename                      types.Bool   `tfsdk:"on_rename"`
-	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
-	OnDownload                    types.Bool   `tfsdk:"on_download"`
-	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	Tags                          types.Set      `tfsdk:"tags"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+	Host                          types.String   `tfsdk:"host"`
+	APIKey                        types.String   `tfsdk:"api_key"`
+	Name                          types.String   `tfsdk:"name"`
+	ID                            types.Int64    `tfsdk:"id"`
+	Port                          types.Int64    `tfsdk:"port"`
+	UpdateLibrary                 types.Bool     `tfsdk:"update_library"`
+	Notify                        types.Bool     `tfsdk:"notify"`
+	UseSSL                        types.Bool     `tfsdk:"use_ssl"`
+	OnGrab                        types.Bool     `tfsdk:"on_grab"`
+	OnEpisodeFileDeleteForUpgrade types.Bool     `tfsdk:"on_episode_file_delete_for_upgrade"`
+	OnEpisodeFileDelete           types.Bool     `tfsdk:"on_episode_file_delete"`
+	IncludeHealthWarnings         types.Bool     `tfsdk:"include_health_warnings"`
+	OnApplicationUpdate           types.Bool     `tfsdk:"on_application_update"`
+	OnHealthIssue                 types.Bool     `tfsdk:"on_health_issue"`
+	OnHealthRestored              types.Bool     `tfsdk:"on_health_restored"`
+	OnSeriesAdd                   types.Bool     `tfsdk:"on_series_add"`
+	OnSeriesDelete                types.Bool     `tfsdk:"on_series_delete"`
+	OnRename                      types.Bool     `tfsdk:"on_rename"`
+	OnUpgrade                     types.Bool     `tfsdk:"on_upgrade"`
+	OnDownload                    types.Bool     `tfsdk:"on_download"`
+	OnImportComplete              types.Bool     `tfsdk:"on_import_complete"`
 }
 
 func (n NotificationEmby) toNotification() *Notification {
@@ -122,7 +131,7 @@ func (r *NotificationEmbyResource) Metadata(_ context.Context, req resource.Meta
 	resp.TypeName = req.ProviderTypeName + "_" + notificationEmbyResourceName
 }
 
-func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NotificationEmbyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Emby resource.\nFor more information refer to [Notification](https://wiki.servarr.com/sonarr/settings#connect) and [Emby](https://wiki.servarr.com/sonarr/supported#mediabrowser).",
 		Attributes: map[string]schema.Attribute{
@@ -239,6 +248,14 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -246,9 +263,20 @@ func (r *NotificationEmbyResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.version = resourceServerVersion(req)
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
+		r.detectSecretDrift = resourceDetectSecretDrift(req)
 	}
 }
 
+func (r *NotificationEmbyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkServerVersion(ctx, r.version, req.Config, notificationEmbyResourceName, notificationVersionRequirements)...)
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, notificationEmbyResourceName, req.State, resp.Plan)...)
+}
+
 func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmby
@@ -259,20 +287,27 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.CreateNotification(ctx).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmbyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Create, notificationEmbyResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -285,18 +320,33 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get NotificationEmby current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(notificationEmbyResourceName, notification.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmbyResourceName, err))
 
 		return
 	}
 
+	if r.detectSecretDrift {
+		checkSecretDrift(ctx, req.Private, notification.read(ctx, &resp.Diagnostics).GetFields(), response.Fields, notificationSecretTest(ctx, r.client, *response), notificationEmbyResourceName, &resp.Diagnostics)
+	}
+
 	tflog.Trace(ctx, "read "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -309,33 +359,54 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, notification.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.NotificationAPI.GetNotificationById(ctx, int32(notification.ID.ValueInt64())).Execute, helpers.ClearedFields(notification, notificationFields), notificationEmbyResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(ctx, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmbyResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, notificationFields, helpers.Update, notificationEmbyResourceName, err)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+notificationEmbyResourceName+": "+strconv.Itoa(int(response.GetId())))
+	resp.Diagnostics.Append(storeSecretHashes(ctx, resp.Private, request.GetFields(), response.GetFields())...)
 	// Generate resource state struct
 	notification.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete NotificationEmby current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationEmbyResourceName, err))
 
@@ -347,10 +418,19 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationEmbyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	notificationImporter(r.client, notificationEmbyImplementation).importState(ctx, r.auth, notificationEmbyResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+notificationEmbyResourceName+": "+req.ID)
 }
 
+func (r *NotificationEmbyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return notificationMover(ctx, notificationEmbyImplementation, func(notification *NotificationResourceModel) interface{} {
+		moved := NotificationEmby{Timeouts: notification.Timeouts}
+		moved.fromNotification(&notification.Notification)
+
+		return &moved
+	})
+}
+
 func (n *NotificationEmby) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
 	genericNotification := n.toNotification()
 	genericNotification.write(ctx, notification, diags)
@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -40,6 +42,25 @@ func TestAccNotificationResource(t *testing.T) {
 					resource.TestCheckResourceAttr("sonarr_notification.test", "on_upgrade", "true"),
 				),
 			},
+			// Out of band deletion testing
+			{
+				Config: testAccNotificationResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceDisappears("sonarr_notification.test", func(ctx context.Context, client *sonarr.APIClient, id int32) error {
+						_, err := client.NotificationAPI.DeleteNotification(ctx, id).Execute()
+
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Re-create after out of band deletion
+			{
+				Config: testAccNotificationResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_notification.test", "id"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "sonarr_notification.test",
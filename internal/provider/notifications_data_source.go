@@ -447,7 +447,7 @@ func (d *NotificationsDataSource) Configure(ctx context.Context, req datasource.
 
 func (d *NotificationsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get notifications current value
-	response, _, err := d.client.NotificationAPI.ListNotification(d.auth).Execute()
+	response, _, err := d.client.NotificationAPI.ListNotification(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationsDataSourceName, err))
 
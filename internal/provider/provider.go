@@ -2,20 +2,42 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// define default values for provider configuration.
+const (
+	providerTypeName    = "sonarr"
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1
+	defaultRetryMaxWait = 30
+	defaultReadyTimeout = 300
+	readyPollInterval   = 5 * time.Second
+)
+
+// errUnsupportedProxy is returned for proxy URLs not supported by the HTTP client.
+var errUnsupportedProxy = errors.New("unsupported proxy")
+
 // needed for tf debug mode
 // var stderr = os.Stderr
 
@@ -32,9 +54,31 @@ type SonarrProvider struct {
 
 // Sonarr describes the provider data model.
 type Sonarr struct {
-	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
-	APIKey       types.String `tfsdk:"api_key"`
-	URL          types.String `tfsdk:"url"`
+	ExtraHeaders          types.Set    `tfsdk:"extra_headers"`
+	DefaultTags           types.Set    `tfsdk:"default_tags"`
+	APIKey                types.String `tfsdk:"api_key"`
+	APIKeyFile            types.String `tfsdk:"api_key_file"`
+	ConfigXMLPath         types.String `tfsdk:"config_xml_path"`
+	URL                   types.String `tfsdk:"url"`
+	URLBase               types.String `tfsdk:"url_base"`
+	CACertificate         types.String `tfsdk:"ca_certificate"`
+	ClientCertificate     types.String `tfsdk:"client_certificate"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	RequiredVersion       types.String `tfsdk:"required_version"`
+	HTTPProxy             types.String `tfsdk:"http_proxy"`
+	UserAgent             types.String `tfsdk:"user_agent"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait          types.Int64  `tfsdk:"retry_min_wait"`
+	RetryMaxWait          types.Int64  `tfsdk:"retry_max_wait"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	MaxConcurrentWrites   types.Int64  `tfsdk:"max_concurrent_writes"`
+	ListCacheTTL          types.Int64  `tfsdk:"list_cache_ttl"`
+	RequestTimeout        types.Int64  `tfsdk:"request_timeout"`
+	ReadyTimeout          types.Int64  `tfsdk:"ready_timeout"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	WaitForReady          types.Bool   `tfsdk:"wait_for_ready"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+	DetectSecretDrift     types.Bool   `tfsdk:"detect_secret_drift"`
 }
 
 // ExtraHeader is part of Sonarr.
@@ -45,12 +89,16 @@ type ExtraHeader struct {
 
 // SonarrData defines auth and client to be used when connecting to Sonarr.
 type SonarrData struct {
-	Auth   context.Context
-	Client *sonarr.APIClient
+	Auth              context.Context
+	Client            *sonarr.APIClient
+	Version           *version.Version
+	DefaultTags       []int64
+	ReadOnly          bool
+	DetectSecretDrift bool
 }
 
 func (p *SonarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "sonarr"
+	resp.TypeName = providerTypeName
 	resp.Version = p.version
 }
 
@@ -63,8 +111,20 @@ func (p *SonarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API key (e.g. a mounted secret). It takes precedence over `config_xml_path` and `api_key`. Can be specified via the `SONARR_API_KEY_FILE` environment variable.",
+				Optional:            true,
+			},
+			"config_xml_path": schema.StringAttribute{
+				MarkdownDescription: "Path to Sonarr `config.xml`, used when the provider runs next to Sonarr. API key, port, SSL settings and URL base are read from it, while `url` and `url_base` still take precedence. Can be specified via the `SONARR_CONFIG_XML` environment variable.",
+				Optional:            true,
+			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "Full Sonarr URL with protocol and port (e.g. `https://test.sonarr.tv:8989`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `SONARR_URL` environment variable.",
+				MarkdownDescription: "Full Sonarr URL with protocol, port and optional URL base (e.g. `https://test.sonarr.tv:8989` or `https://media.example.com/sonarr`). You should **NOT** supply the API path (`/api`), the SDK will use the appropriate paths. Can be specified via the `SONARR_URL` environment variable.",
+				Optional:            true,
+			},
+			"url_base": schema.StringAttribute{
+				MarkdownDescription: "URL base of Sonarr when served on a sub-path (e.g. `/sonarr`). It overrides any path supplied in `url`. Can be specified via the `SONARR_URL_BASE` environment variable.",
 				Optional:            true,
 			},
 			"extra_headers": schema.SetNestedAttribute{
@@ -83,6 +143,108 @@ func (p *SonarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					},
 				},
 			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle, or path to a PEM file, used to verify the Sonarr server certificate in addition to the system ones. Can be specified via the `SONARR_CA_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate, or path to a PEM file, for mutual TLS authentication. It requires `client_key`. Can be specified via the `SONARR_CLIENT_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key, or path to a PEM file, for mutual TLS authentication. It requires `client_certificate`. Can be specified via the `SONARR_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the Sonarr server certificate. Use only for testing purposes. Can be specified via the `SONARR_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				MarkdownDescription: "Wait for Sonarr to be up and to accept the API key before configuring the provider, e.g. while it is still migrating its database. Can be specified via the `SONARR_WAIT_FOR_READY` environment variable.",
+				Optional:            true,
+			},
+			"ready_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait for Sonarr when `wait_for_ready` is enabled. Defaults to `300`. Can be specified via the `SONARR_READY_TIMEOUT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"required_version": schema.StringAttribute{
+				MarkdownDescription: "Sonarr version constraint (e.g. `>= 4.0.10, < 5.0.0`). The provider fails to configure if the server version does not satisfy it. Can be specified via the `SONARR_REQUIRED_VERSION` environment variable.",
+				Optional:            true,
+			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags, as labels or IDs, merged with the `tags` of every taggable resource (series, indexers, download clients, notifications, import lists, delay profiles, release profiles, metadata and auto tags) when sending it to Sonarr. Default tags are not stored in `tags`, so they never show as drift. Labels must already exist in Sonarr. Keep in mind that tags restrict which series a resource applies to.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for transient failures (e.g. `database is locked` errors or `502`/`503` responses while Sonarr restarts). Connection errors, `502` and `504` responses are retried only for idempotent requests. Defaults to `3`. Can be specified via the `SONARR_MAX_RETRIES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_min_wait": schema.Int64Attribute{
+				MarkdownDescription: "Minimum wait in seconds before retrying a failed request, doubled at every attempt. Defaults to `1`. Can be specified via the `SONARR_RETRY_MIN_WAIT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum wait in seconds before retrying a failed request. Defaults to `30`. Can be specified via the `SONARR_RETRY_MAX_WAIT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent requests to Sonarr, shared by all resources and data sources. `0` means unlimited. Defaults to `0`. Can be specified via the `SONARR_MAX_CONCURRENT_REQUESTS` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_writes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent write requests (`POST`, `PUT`, `DELETE`) to Sonarr. Lower it to avoid `database is locked` errors on SQLite backends when applying many resources. `0` means unlimited. Defaults to `0`. Can be specified via the `SONARR_MAX_CONCURRENT_WRITES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Prevent any change to Sonarr, e.g. to detect drift on production instances. Plans creating, updating or deleting resources fail, while resources can still be read and data sources keep working. Can be specified via the `SONARR_READ_ONLY` environment variable.",
+				Optional:            true,
+			},
+			"detect_secret_drift": schema.BoolAttribute{
+				MarkdownDescription: "Detect secrets (e.g. passwords and API keys) changed outside of Terraform. Sonarr never returns secrets, so the provider keeps a salted hash of the ones it sends and, on refresh, calls the `test` endpoint of download clients, import lists, indexers and notifications to check the secrets stored by Sonarr. Secrets without a hash, e.g. of imported resources, are checked with the `test` endpoint only. Drifted secrets are sent again on next apply. Since every refresh tests the connection to the related service, it is disabled by default. Can be specified via the `SONARR_DETECT_SECRET_DRIFT` environment variable.",
+				Optional:            true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "Proxy URL for Sonarr requests, e.g. `http://proxy.example.com:3128` or `socks5://proxy.example.com:1080`. Defaults to the standard `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables. Can be specified via the `SONARR_HTTP_PROXY` environment variable.",
+				Optional:            true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds for a single request to Sonarr, retries excluded. `0` means no timeout. Defaults to `0`. Can be specified via the `SONARR_REQUEST_TIMEOUT` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"user_agent": schema.StringAttribute{
+				MarkdownDescription: "Product appended to the `User-Agent` header of Sonarr requests (e.g. `my-pipeline/1.0`), which always reports the Terraform and provider versions (e.g. `Terraform/1.9.0 terraform-provider-sonarr/3.3.0`). Can be specified via the `SONARR_USER_AGENT` environment variable.",
+				Optional:            true,
+			},
+			"list_cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Time in seconds to keep list responses (e.g. all series or all tags) in memory, so that data sources looking up items of the same kind do not download the whole list every time. Cached lists are invalidated whenever an item of the same kind is written. `0` disables the cache. Defaults to `0`. Can be specified via the `SONARR_LIST_CACHE_TTL` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -96,12 +258,33 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Read config.xml if provided
+	var configXML *helpers.SonarrConfigXML
+
+	if configXMLPath := stringConfig(data.ConfigXMLPath, "SONARR_CONFIG_XML"); configXMLPath != "" {
+		var err error
+
+		configXML, err = helpers.ReadConfigXML(configXMLPath)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read Sonarr config.xml",
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
 	// Extract URL
 	APIURL := data.URL.ValueString()
 	if APIURL == "" {
 		APIURL = os.Getenv("SONARR_URL")
 	}
 
+	if APIURL == "" && configXML != nil {
+		APIURL = configXML.URL()
+	}
+
 	parsedAPIURL, err := url.Parse(APIURL)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -112,8 +295,41 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Extract key
-	key := data.APIKey.ValueString()
+	// Extract URL base, falling back to the URL path
+	urlBase := stringConfig(data.URLBase, "SONARR_URL_BASE")
+	if urlBase == "" {
+		urlBase = parsedAPIURL.Path
+	}
+
+	if urlBase == "" && configXML != nil {
+		urlBase = configXML.URLBase
+	}
+
+	urlBase = normalizeURLBase(urlBase)
+
+	// Extract key, reading it from disk first
+	var key string
+
+	if apiKeyFile := stringConfig(data.APIKeyFile, "SONARR_API_KEY_FILE"); apiKeyFile != "" {
+		key, err = helpers.ReadSecretFile(apiKeyFile)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read API key file",
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
+	if key == "" && configXML != nil {
+		key = configXML.APIKey
+	}
+
+	if key == "" {
+		key = data.APIKey.ValueString()
+	}
+
 	if key == "" {
 		key = os.Getenv("SONARR_API_KEY")
 	}
@@ -127,8 +343,146 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Extract retry configuration
+	maxRetries, err := int64Config(data.MaxRetries, "SONARR_MAX_RETRIES", defaultMaxRetries)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid max retries",
+			"SONARR_MAX_RETRIES must be an integer",
+		)
+
+		return
+	}
+
+	retryMinWait, err := int64Config(data.RetryMinWait, "SONARR_RETRY_MIN_WAIT", defaultRetryMinWait)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid retry min wait",
+			"SONARR_RETRY_MIN_WAIT must be an integer",
+		)
+
+		return
+	}
+
+	retryMaxWait, err := int64Config(data.RetryMaxWait, "SONARR_RETRY_MAX_WAIT", defaultRetryMaxWait)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid retry max wait",
+			"SONARR_RETRY_MAX_WAIT must be an integer",
+		)
+
+		return
+	}
+
+	// Extract concurrency configuration
+	maxConcurrentRequests, err := int64Config(data.MaxConcurrentRequests, "SONARR_MAX_CONCURRENT_REQUESTS", 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid max concurrent requests",
+			"SONARR_MAX_CONCURRENT_REQUESTS must be an integer",
+		)
+
+		return
+	}
+
+	maxConcurrentWrites, err := int64Config(data.MaxConcurrentWrites, "SONARR_MAX_CONCURRENT_WRITES", 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid max concurrent writes",
+			"SONARR_MAX_CONCURRENT_WRITES must be an integer",
+		)
+
+		return
+	}
+
+	listCacheTTL, err := int64Config(data.ListCacheTTL, "SONARR_LIST_CACHE_TTL", 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid list cache TTL",
+			"SONARR_LIST_CACHE_TTL must be an integer",
+		)
+
+		return
+	}
+
+	readOnly, err := boolConfig(data.ReadOnly, "SONARR_READ_ONLY")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid read only",
+			"SONARR_READ_ONLY must be a boolean",
+		)
+
+		return
+	}
+
+	detectSecretDrift, err := boolConfig(data.DetectSecretDrift, "SONARR_DETECT_SECRET_DRIFT")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid detect secret drift",
+			"SONARR_DETECT_SECRET_DRIFT must be a boolean",
+		)
+
+		return
+	}
+
+	requestTimeout, err := int64Config(data.RequestTimeout, "SONARR_REQUEST_TIMEOUT", 0)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid request timeout",
+			"SONARR_REQUEST_TIMEOUT must be an integer",
+		)
+
+		return
+	}
+
+	// Extract TLS configuration
+	insecureSkipVerify, err := boolConfig(data.InsecureSkipVerify, "SONARR_INSECURE_SKIP_VERIFY")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid insecure skip verify",
+			"SONARR_INSECURE_SKIP_VERIFY must be a boolean",
+		)
+
+		return
+	}
+
+	tlsConfig, err := helpers.TLSConfig(
+		stringConfig(data.CACertificate, "SONARR_CA_CERTIFICATE"),
+		stringConfig(data.ClientCertificate, "SONARR_CLIENT_CERTIFICATE"),
+		stringConfig(data.ClientKey, "SONARR_CLIENT_KEY"),
+		insecureSkipVerify,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to configure TLS",
+			err.Error(),
+		)
+
+		return
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert // default transport is always *http.Transport
+	transport.TLSClientConfig = tlsConfig
+
+	// Use the configured proxy instead of the environment ones
+	if httpProxy := stringConfig(data.HTTPProxy, "SONARR_HTTP_PROXY"); httpProxy != "" {
+		var proxyURL *url.URL
+
+		if proxyURL, err = parseProxyURL(httpProxy); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to find valid HTTP proxy",
+				err.Error(),
+			)
+
+			return
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
 	// Init config
 	config := sonarr.NewConfiguration()
+	config.UserAgent = userAgent(req.TerraformVersion, p.version, stringConfig(data.UserAgent, "SONARR_USER_AGENT"))
 	// Check extra headers
 	if len(data.ExtraHeaders.Elements()) > 0 {
 		headers := make([]ExtraHeader, len(data.ExtraHeaders.Elements()))
@@ -147,9 +501,48 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
-	// Set context for API calls
+	// Mask API key and extra header values in HTTP logs
+	secrets := []string{key}
+	for _, value := range config.DefaultHeader {
+		secrets = append(secrets, value)
+	}
+
+	// Throttle requests between retries and logging, so that backoff waits do not hold a slot
+	limiter := helpers.NewConcurrencyLimiter(int(maxConcurrentRequests), int(maxConcurrentWrites))
+
+	attemptTransport := limiter.Transport(&helpers.TimeoutTransport{
+		Base: &helpers.LoggingTransport{
+			Base:    transport,
+			Secrets: secrets,
+		},
+		Timeout: time.Duration(requestTimeout) * time.Second,
+	})
+
+	var apiTransport http.RoundTripper = &helpers.RetryTransport{
+		Base:       attemptTransport,
+		MaxRetries: int(maxRetries),
+		MinWait:    time.Duration(retryMinWait) * time.Second,
+		MaxWait:    time.Duration(retryMaxWait) * time.Second,
+	}
+
+	// Serve list requests from the cache when enabled
+	if listCacheTTL > 0 {
+		apiTransport = helpers.NewListCache(time.Duration(listCacheTTL) * time.Second).Transport(apiTransport)
+	}
+
+	// Reject any write in read-only mode, whatever the caller
+	if readOnly {
+		apiTransport = &helpers.ReadOnlyTransport{Base: apiTransport}
+	}
+
+	// Probe the system status without retries: waitForSonarr polls on its own and the version is optional
+	probeConfig := *config
+	probeConfig.HTTPClient = &http.Client{Transport: attemptTransport}
+	config.HTTPClient = &http.Client{Transport: apiTransport}
+
+	// Set context for API calls, keeping the provider logger for transport logging
 	auth := context.WithValue(
-		context.Background(),
+		context.WithoutCancel(ctx),
 		sonarr.ContextAPIKeys,
 		map[string]sonarr.APIKey{
 			"X-Api-Key": {Key: key},
@@ -157,13 +550,81 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	)
 	auth = context.WithValue(auth, sonarr.ContextServerVariables, map[string]string{
 		"protocol": parsedAPIURL.Scheme,
-		"hostpath": parsedAPIURL.Host,
+		"hostpath": parsedAPIURL.Host + urlBase,
 	})
 
 	sonarrData := SonarrData{
-		Auth:   auth,
-		Client: sonarr.NewAPIClient(config),
+		Auth:              auth,
+		Client:            sonarr.NewAPIClient(config),
+		ReadOnly:          readOnly,
+		DetectSecretDrift: detectSecretDrift,
+	}
+	probe := SonarrData{Auth: auth, Client: sonarr.NewAPIClient(&probeConfig)}
+
+	// Wait for Sonarr if requested
+	waitForReady, err := boolConfig(data.WaitForReady, "SONARR_WAIT_FOR_READY")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid wait for ready",
+			"SONARR_WAIT_FOR_READY must be a boolean",
+		)
+
+		return
+	}
+
+	readyTimeout, err := int64Config(data.ReadyTimeout, "SONARR_READY_TIMEOUT", defaultReadyTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to find valid ready timeout",
+			"SONARR_READY_TIMEOUT must be an integer",
+		)
+
+		return
+	}
+
+	if waitForReady {
+		resp.Diagnostics.Append(waitForSonarr(ctx, &probe, time.Duration(readyTimeout)*time.Second)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
+
+	// Detect server version
+	status, _, err := probe.Client.SystemAPI.GetSystemStatus(requestContext(ctx, probe.Auth)).Execute()
+	if err != nil {
+		tflog.Debug(ctx, "unable to detect Sonarr version: "+err.Error())
+	} else {
+		sonarrData.Version = parseServerVersion(ctx, status.GetVersion())
+
+		if urlBase != "" {
+			validateURLBase(status, urlBase, &resp.Diagnostics)
+		}
+	}
+
+	if requiredVersion := stringConfig(data.RequiredVersion, "SONARR_REQUIRED_VERSION"); requiredVersion != "" {
+		resp.Diagnostics.Append(checkRequiredVersion(sonarrData.Version, requiredVersion)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Resolve default tags
+	if len(data.DefaultTags.Elements()) > 0 {
+		defaultTags := make([]string, len(data.DefaultTags.Elements()))
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &defaultTags, false)...)
+
+		var diags diag.Diagnostics
+
+		sonarrData.DefaultTags, diags = resolveDefaultTags(ctx, &sonarrData, defaultTags)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = &sonarrData
 	resp.ResourceData = &sonarrData
 }
@@ -361,6 +822,123 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// parseProxyURL parses the proxy URL, supporting HTTP, HTTPS and SOCKS5 proxies.
+func parseProxyURL(value string) (*url.URL, error) {
+	proxyURL, err := url.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5", "socks5h":
+	default:
+		return nil, fmt.Errorf("%w: %q, expected http, https, socks5 or socks5h", errUnsupportedProxy, proxyURL.Scheme)
+	}
+
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("%w: missing host in %q", errUnsupportedProxy, proxyURL.Redacted())
+	}
+
+	return proxyURL, nil
+}
+
+// userAgent returns the User-Agent reporting the Terraform and provider versions, followed by the custom product if any.
+func userAgent(terraformVersion, providerVersion, product string) string {
+	agent := fmt.Sprintf("Terraform/%s terraform-provider-sonarr/%s", terraformVersion, providerVersion)
+	if product != "" {
+		agent += " " + product
+	}
+
+	return agent
+}
+
+// normalizeURLBase returns the URL base with a leading slash and without trailing ones.
+func normalizeURLBase(urlBase string) string {
+	urlBase = strings.Trim(urlBase, "/")
+	if urlBase == "" {
+		return ""
+	}
+
+	return "/" + urlBase
+}
+
+// validateURLBase warns when the configured URL base differs from the one reported by Sonarr.
+func validateURLBase(status *sonarr.SystemResource, urlBase string, diags *diag.Diagnostics) {
+	if serverURLBase := normalizeURLBase(status.GetUrlBase()); serverURLBase != urlBase {
+		diags.AddWarning(
+			"URL base mismatch",
+			fmt.Sprintf("Configured URL base '%s' differs from Sonarr URL base '%s'. This is expected only if a reverse proxy rewrites the request path.", urlBase, serverURLBase),
+		)
+	}
+}
+
+// waitForSonarr polls the system status until Sonarr answers with a valid API key or the timeout expires.
+func waitForSonarr(ctx context.Context, data *SonarrData, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	pollCtx, cancel := context.WithTimeout(requestContext(ctx, data.Auth), timeout)
+	defer cancel()
+
+	for {
+		_, _, err := data.Client.SystemAPI.GetSystemStatus(pollCtx).Execute()
+		if err == nil {
+			return diags
+		}
+
+		tflog.Debug(ctx, "waiting for Sonarr to be ready: "+err.Error())
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Sonarr not ready", "Provider configuration canceled while waiting for Sonarr.")
+
+			return diags
+		case <-pollCtx.Done():
+			diags.AddError(
+				"Sonarr not ready",
+				fmt.Sprintf("Sonarr did not respond with a valid API key within %s, last error: %s", timeout, err),
+			)
+
+			return diags
+		case <-time.After(readyPollInterval):
+		}
+	}
+}
+
+// int64Config returns the configured value, falling back to the environment variable and then to the default.
+func int64Config(value types.Int64, env string, def int64) (int64, error) {
+	if !value.IsNull() {
+		return value.ValueInt64(), nil
+	}
+
+	if v := os.Getenv(env); v != "" {
+		return strconv.ParseInt(v, 10, 64)
+	}
+
+	return def, nil
+}
+
+// stringConfig returns the configured value, falling back to the environment variable.
+func stringConfig(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// boolConfig returns the configured value, falling back to the environment variable.
+func boolConfig(value types.Bool, env string) (bool, error) {
+	if !value.IsNull() {
+		return value.ValueBool(), nil
+	}
+
+	if v := os.Getenv(env); v != "" {
+		return strconv.ParseBool(v)
+	}
+
+	return false, nil
+}
+
 // ResourceConfigure is a helper function to set the client for a specific resource.
 func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
 	// Prevent panic if the provider has not been configured.
@@ -91,7 +91,7 @@ func (d *QualityDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	// Get qualitys current value
-	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(d.auth).Execute()
+	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDataSourceName, err))
 
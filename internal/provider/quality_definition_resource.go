@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +23,7 @@ const qualityDefinitionResourceName = "quality_definition"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &QualityDefinitionResource{}
+	_ resource.ResourceWithModifyPlan  = &QualityDefinitionResource{}
 	_ resource.ResourceWithImportState = &QualityDefinitionResource{}
 )
 
@@ -31,8 +33,9 @@ func NewQualityDefinitionResource() resource.Resource {
 
 // QualityDefinitionResource defines the quality definition implementation.
 type QualityDefinitionResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // QualityDefinition describes the quality definition data model.
@@ -47,6 +50,12 @@ type QualityDefinition struct {
 	Resolution  types.Int64   `tfsdk:"resolution"`
 }
 
+// QualityDefinitionResourceModel describes the quality definition resource data model, including timeouts.
+type QualityDefinitionResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	QualityDefinition
+}
+
 func (p QualityDefinition) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -65,7 +74,7 @@ func (r *QualityDefinitionResource) Metadata(_ context.Context, req resource.Met
 	resp.TypeName = req.ProviderTypeName + "_" + qualityDefinitionResourceName
 }
 
-func (r *QualityDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *QualityDefinitionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nQuality Definition resource.\nFor more information refer to [Quality Definition](https://wiki.servarr.com/sonarr/settings#quality-1) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -110,6 +119,13 @@ func (r *QualityDefinitionResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -117,12 +133,17 @@ func (r *QualityDefinitionResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *QualityDefinitionResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, qualityDefinitionResourceName, req.State, resp.Plan)...)
+}
+
 func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var definition *QualityDefinition
+	var definition *QualityDefinitionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &definition)...)
 
@@ -130,13 +151,16 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, definition.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Create resource
 	request := definition.read()
 
 	// Read to get the quality ID
-	read, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, request.GetId()).Execute()
+	read, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(ctx, request.GetId()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, qualityDefinitionResourceName, err)
 
 		return
 	}
@@ -145,9 +169,9 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	request.Quality.SetSource(read.Quality.GetSource())
 
 	// Create new QualityDefinition
-	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
+	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(ctx, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, qualityDefinitionResourceName, err)
 
 		return
 	}
@@ -160,7 +184,7 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 
 func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var definition *QualityDefinition
+	var definition *QualityDefinitionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &definition)...)
 
@@ -168,9 +192,19 @@ func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, definition.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get qualitydefinition current value
-	response, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, int32(definition.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(ctx, int32(definition.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(qualityDefinitionResourceName, definition.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionResourceName, err))
 
 		return
@@ -184,7 +218,7 @@ func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadR
 
 func (r *QualityDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var definition *QualityDefinition
+	var definition *QualityDefinitionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &definition)...)
 
@@ -192,13 +226,23 @@ func (r *QualityDefinitionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, definition.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
 	request := definition.read()
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.QualityDefinitionAPI.GetQualityDefinitionById(ctx, int32(definition.ID.ValueInt64())).Execute, nil, qualityDefinitionResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update QualityDefinition
-	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
+	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(ctx, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, qualityDefinitionResourceName, err)
 
 		return
 	}
@@ -224,7 +268,7 @@ func (r *QualityDefinitionResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *QualityDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	qualityDefinitionImporter(r.client).importState(ctx, r.auth, qualityDefinitionResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+qualityDefinitionResourceName+": "+req.ID)
 }
 
@@ -100,7 +100,7 @@ func (d *QualityDefinitionsDataSource) Configure(ctx context.Context, req dataso
 
 func (d *QualityDefinitionsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get qualitydefinitions current value
-	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(d.auth).Execute()
+	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -24,6 +25,7 @@ const qualityProfileResourceName = "quality_profile"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &QualityProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &QualityProfileResource{}
 	_ resource.ResourceWithImportState = &QualityProfileResource{}
 )
 
@@ -33,8 +35,9 @@ func NewQualityProfileResource() resource.Resource {
 
 // QualityProfileResource defines the quality profile implementation.
 type QualityProfileResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // QualityProfile describes the quality profile data model.
@@ -49,6 +52,12 @@ type QualityProfile struct {
 	UpgradeAllowed    types.Bool   `tfsdk:"upgrade_allowed"`
 }
 
+// QualityProfileResourceModel describes the quality profile resource data model, including timeouts.
+type QualityProfileResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	QualityProfile
+}
+
 func (p QualityProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -99,7 +108,7 @@ func (r *QualityProfileResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_" + qualityProfileResourceName
 }
 
-func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *QualityProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nQuality Profile resource.\nFor more information refer to [Quality Profile](https://wiki.servarr.com/sonarr/settings#quality-profiles) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -150,6 +159,14 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -243,12 +260,18 @@ func (r *QualityProfileResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *QualityProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkReferences(ctx, r.auth, req, resp, customFormatReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, qualityProfileResourceName, req.State, resp.Plan)...)
+}
+
 func (r *QualityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *QualityProfile
+	var profile *QualityProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -256,13 +279,16 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Create resource
-	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+	request := profile.read(ctx, r.getQualityIDs(ctx, &resp.Diagnostics), r.getFormatsIDs(ctx, &resp.Diagnostics), &resp.Diagnostics)
 
 	// Create new QualityProfile
-	response, _, err := r.client.QualityProfileAPI.CreateQualityProfile(r.auth).QualityProfileResource(*request).Execute()
+	response, _, err := r.client.QualityProfileAPI.CreateQualityProfile(ctx).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, qualityProfileResourceName, err)
 
 		return
 	}
@@ -275,7 +301,7 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 
 func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *QualityProfile
+	var profile *QualityProfileResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -283,9 +309,19 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get qualityprofile current value
-	response, _, err := r.client.QualityProfileAPI.GetQualityProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityProfileAPI.GetQualityProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(qualityProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileResourceName, err))
 
 		return
@@ -299,7 +335,7 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 
 func (r *QualityProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *QualityProfile
+	var profile *QualityProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -307,13 +343,23 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
-	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+	request := profile.read(ctx, r.getQualityIDs(ctx, &resp.Diagnostics), r.getFormatsIDs(ctx, &resp.Diagnostics), &resp.Diagnostics)
+
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.QualityProfileAPI.GetQualityProfileById(ctx, int32(profile.ID.ValueInt64())).Execute, nil, qualityProfileResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Update QualityProfile
-	response, _, err := r.client.QualityProfileAPI.UpdateQualityProfile(r.auth, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
+	response, _, err := r.client.QualityProfileAPI.UpdateQualityProfile(ctx, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, qualityProfileResourceName, err)
 
 		return
 	}
@@ -325,16 +371,23 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *QualityProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete qualityprofile current value
-	_, err := r.client.QualityProfileAPI.DeleteQualityProfile(r.auth, int32(ID)).Execute()
+	_, err := r.client.QualityProfileAPI.DeleteQualityProfile(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, qualityProfileResourceName, err))
 
@@ -346,7 +399,7 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	qualityProfileImporter(r.client).importState(ctx, r.auth, qualityProfileResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
@@ -549,9 +602,9 @@ func (f *FormatItem) read() *sonarr.ProfileFormatItemResource {
 	return formatItem
 }
 
-func (r QualityProfileResource) getQualityIDs(diags *diag.Diagnostics) []int32 {
+func (r QualityProfileResource) getQualityIDs(ctx context.Context, diags *diag.Diagnostics) []int32 {
 	// Get qualitydefinitions current value
-	qualities, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(r.auth).Execute()
+	qualities, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
 	if err != nil {
 		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
 
@@ -570,9 +623,9 @@ func (r QualityProfileResource) getQualityIDs(diags *diag.Diagnostics) []int32 {
 	return qualityIDs
 }
 
-func (r QualityProfileResource) getFormatsIDs(diags *diag.Diagnostics) []int32 {
+func (r QualityProfileResource) getFormatsIDs(ctx context.Context, diags *diag.Diagnostics) []int32 {
 	// Get qualitydefinitions current value
-	formats, _, err := r.client.CustomFormatAPI.ListCustomFormat(r.auth).Execute()
+	formats, _, err := r.client.CustomFormatAPI.ListCustomFormat(ctx).Execute()
 	if err != nil {
 		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatsDataSourceName, err))
 
@@ -151,7 +151,7 @@ func (d *QualityProfilesDataSource) Configure(ctx context.Context, req datasourc
 
 func (d *QualityProfilesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get qualityprofiles current value
-	response, _, err := d.client.QualityProfileAPI.ListQualityProfile(d.auth).Execute()
+	response, _, err := d.client.QualityProfileAPI.ListQualityProfile(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfilesDataSourceName, err))
 
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// checkReadOnly fails any plan creating, updating or deleting a resource when the provider is in read-only mode.
+func checkReadOnly(readOnly bool, resourceName string, state tfsdk.State, plan tfsdk.Plan) diag.Diagnostics {
+	var (
+		diags  diag.Diagnostics
+		action string
+	)
+
+	switch {
+	case !readOnly:
+		return diags
+	case state.Raw.IsNull():
+		action = helpers.Create
+	case plan.Raw.IsNull():
+		action = helpers.Delete
+	case !plan.Raw.Equal(state.Raw):
+		action = helpers.Update
+	default:
+		return diags
+	}
+
+	diags.AddError(helpers.ReadOnlyMode, fmt.Sprintf("Unable to %s %s, the provider is configured in read-only mode.", action, resourceName))
+
+	return diags
+}
+
+// resourceReadOnly returns whether the provider is in read-only mode.
+func resourceReadOnly(req resource.ConfigureRequest) bool {
+	if providerData, ok := req.ProviderData.(*SonarrData); ok {
+		return providerData.ReadOnly
+	}
+
+	return false
+}
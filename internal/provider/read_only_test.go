@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckReadOnly(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{Required: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"label": tftypes.String}}
+	labelValue := func(value interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"label": tftypes.NewValue(tftypes.String, value)})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	tests := map[string]struct {
+		state    tftypes.Value
+		plan     tftypes.Value
+		readOnly bool
+		err      bool
+	}{
+		"create": {
+			state:    null,
+			plan:     labelValue("test"),
+			readOnly: true,
+			err:      true,
+		},
+		"update": {
+			state:    labelValue("test"),
+			plan:     labelValue("changed"),
+			readOnly: true,
+			err:      true,
+		},
+		"delete": {
+			state:    labelValue("test"),
+			plan:     null,
+			readOnly: true,
+			err:      true,
+		},
+		"no_changes": {
+			state:    labelValue("test"),
+			plan:     labelValue("test"),
+			readOnly: true,
+			err:      false,
+		},
+		"read_write": {
+			state:    null,
+			plan:     labelValue("test"),
+			readOnly: false,
+			err:      false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := tfsdk.State{Schema: testSchema, Raw: test.state}
+			plan := tfsdk.Plan{Schema: testSchema, Raw: test.plan}
+
+			assert.Equal(t, test.err, checkReadOnly(test.readOnly, "test", state, plan).HasError())
+		})
+	}
+}
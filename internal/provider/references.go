@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idReference describes attributes holding the IDs of other Sonarr objects (e.g. tags).
+type idReference struct {
+	list       func(context.Context) ([]int64, error)
+	kind       string
+	expression path.Expression
+}
+
+// checkReferences reports the planned IDs referencing Sonarr objects which do not exist.
+// Unknown values, e.g. IDs of objects created in the same apply, and unchanged resources are not checked.
+func checkReferences(ctx, auth context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, references ...idReference) {
+	if auth == nil || req.Plan.Raw.IsNull() || resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	for _, reference := range references {
+		planned := make(map[int64]path.Paths)
+
+		matches, diags := resp.Plan.PathMatches(ctx, reference.expression)
+
+		for _, match := range matches {
+			var id types.Int64
+
+			// Null or unknown parents are matched as well.
+			if !reference.expression.Matches(match) {
+				continue
+			}
+
+			diags.Append(resp.Plan.GetAttribute(ctx, match, &id)...)
+
+			// 0 usually stands for any object.
+			if id.ValueInt64() > 0 {
+				planned[id.ValueInt64()] = append(planned[id.ValueInt64()], match)
+			}
+		}
+
+		resp.Diagnostics.Append(diags...)
+
+		if len(planned) == 0 || diags.HasError() {
+			continue
+		}
+
+		existing, err := reference.list(requestContext(ctx, auth))
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.List, reference.kind, err))
+
+			return
+		}
+
+		for id, paths := range planned {
+			if slices.Contains(existing, id) {
+				continue
+			}
+
+			for _, p := range paths {
+				resp.Diagnostics.AddAttributeError(p, helpers.InvalidReference, fmt.Sprintf("No %s with ID %d exists in Sonarr.", reference.kind, id))
+			}
+		}
+	}
+}
+
+// listIDs returns the IDs of the listed objects.
+func listIDs[T any](list func() ([]T, *http.Response, error), getID func(*T) int32) ([]int64, error) {
+	objects, _, err := list()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, len(objects))
+	for i := range objects {
+		ids[i] = int64(getID(&objects[i]))
+	}
+
+	return ids, nil
+}
+
+// tagsReference checks the IDs in the `tags` attribute.
+func tagsReference(client *sonarr.APIClient) idReference {
+	return idReference{
+		list: func(ctx context.Context) ([]int64, error) {
+			return listIDs(client.TagAPI.ListTag(ctx).Execute, (*sonarr.TagResource).GetId)
+		},
+		expression: path.MatchRoot("tags").AtAnySetValue(),
+		kind:       tagResourceName,
+	}
+}
+
+// qualityProfileReference checks the ID in the `quality_profile_id` attribute.
+func qualityProfileReference(client *sonarr.APIClient) idReference {
+	return idReference{
+		list: func(ctx context.Context) ([]int64, error) {
+			return listIDs(client.QualityProfileAPI.ListQualityProfile(ctx).Execute, (*sonarr.QualityProfileResource).GetId)
+		},
+		expression: path.MatchRoot("quality_profile_id"),
+		kind:       qualityProfileResourceName,
+	}
+}
+
+// downloadClientReference checks the ID in the `download_client_id` attribute.
+func downloadClientReference(client *sonarr.APIClient) idReference {
+	return idReference{
+		list: func(ctx context.Context) ([]int64, error) {
+			return listIDs(client.DownloadClientAPI.ListDownloadClient(ctx).Execute, (*sonarr.DownloadClientResource).GetId)
+		},
+		expression: path.MatchRoot("download_client_id"),
+		kind:       downloadClientResourceName,
+	}
+}
+
+// indexerReference checks the ID in the `indexer_id` attribute.
+func indexerReference(client *sonarr.APIClient) idReference {
+	return idReference{
+		list: func(ctx context.Context) ([]int64, error) {
+			return listIDs(client.IndexerAPI.ListIndexer(ctx).Execute, (*sonarr.IndexerResource).GetId)
+		},
+		expression: path.MatchRoot("indexer_id"),
+		kind:       indexerResourceName,
+	}
+}
+
+// customFormatReference checks the custom format IDs in the `format_items` attribute.
+func customFormatReference(client *sonarr.APIClient) idReference {
+	return idReference{
+		list: func(ctx context.Context) ([]int64, error) {
+			return listIDs(client.CustomFormatAPI.ListCustomFormat(ctx).Execute, (*sonarr.CustomFormatResource).GetId)
+		},
+		expression: path.MatchRoot("format_items").AtAnySetValue().AtName("format"),
+		kind:       customFormatResourceName,
+	}
+}
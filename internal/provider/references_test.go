@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckReferences(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"tags":               schema.SetAttribute{Optional: true, ElementType: types.Int64Type},
+			"quality_profile_id": schema.Int64Attribute{Optional: true},
+		},
+	}
+	tagsType := tftypes.Set{ElementType: tftypes.Number}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"tags": tagsType, "quality_profile_id": tftypes.Number}}
+	value := func(profile interface{}, tags ...interface{}) tftypes.Value {
+		elements := make([]tftypes.Value, len(tags))
+		for i, tag := range tags {
+			elements[i] = tftypes.NewValue(tftypes.Number, tag)
+		}
+
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"tags":               tftypes.NewValue(tagsType, elements),
+			"quality_profile_id": tftypes.NewValue(tftypes.Number, profile),
+		})
+	}
+	reference := func(attribute string, ids ...int64) idReference {
+		expression := path.MatchRoot(attribute)
+		if attribute == "tags" {
+			expression = expression.AtAnySetValue()
+		}
+
+		return idReference{
+			list:       func(context.Context) ([]int64, error) { return ids, nil },
+			expression: expression,
+			kind:       attribute,
+		}
+	}
+
+	tests := map[string]struct {
+		state    tftypes.Value
+		plan     tftypes.Value
+		expected diag.Diagnostics
+	}{
+		"existing": {
+			state: tftypes.NewValue(objectType, nil),
+			plan:  value(1, 1, 2),
+		},
+		"missing": {
+			state: tftypes.NewValue(objectType, nil),
+			plan:  value(3, 1, 4),
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("tags").AtSetValue(types.Int64Value(4)), "Invalid Reference", "No tags with ID 4 exists in Sonarr."),
+				diag.NewAttributeErrorDiagnostic(path.Root("quality_profile_id"), "Invalid Reference", "No quality_profile_id with ID 3 exists in Sonarr."),
+			},
+		},
+		"unknown": {
+			state: tftypes.NewValue(objectType, nil),
+			plan:  value(tftypes.UnknownValue, tftypes.UnknownValue),
+		},
+		"any": {
+			state: tftypes.NewValue(objectType, nil),
+			plan:  value(0),
+		},
+		"unchanged": {
+			state: value(3, 4),
+			plan:  value(3, 4),
+		},
+		"destroy": {
+			state: value(3, 4),
+			plan:  tftypes.NewValue(objectType, nil),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: testSchema, Raw: test.state},
+				Plan:  tfsdk.Plan{Schema: testSchema, Raw: test.plan},
+			}
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			checkReferences(context.Background(), context.Background(), req, &resp, reference("tags", 1, 2), reference("quality_profile_id", 1))
+			assert.Equal(t, test.expected, resp.Diagnostics)
+		})
+	}
+}
@@ -88,7 +88,7 @@ func (d *ReleaseProfileDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 	// Get releaseprofiles current value
-	response, _, err := d.client.ReleaseProfileAPI.ListReleaseProfile(d.auth).Execute()
+	response, _, err := d.client.ReleaseProfileAPI.ListReleaseProfile(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseProfileDataSourceName, err))
 
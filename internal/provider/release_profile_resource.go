@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,6 +26,7 @@ const releaseProfileResourceName = "release_profile"
 var (
 	_ resource.Resource                = &ReleaseProfileResource{}
 	_ resource.ResourceWithImportState = &ReleaseProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &ReleaseProfileResource{}
 )
 
 func NewReleaseProfileResource() resource.Resource {
@@ -33,8 +35,10 @@ func NewReleaseProfileResource() resource.Resource {
 
 // ReleaseProfileResource defines the release profile implementation.
 type ReleaseProfileResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // ReleaseProfile describes the release profile data model.
@@ -48,6 +52,12 @@ type ReleaseProfile struct {
 	Enabled   types.Bool   `tfsdk:"enabled"`
 }
 
+// ReleaseProfileResourceModel describes the release profile resource data model, including timeouts.
+type ReleaseProfileResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	ReleaseProfile
+}
+
 func (p ReleaseProfile) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -65,7 +75,7 @@ func (r *ReleaseProfileResource) Metadata(_ context.Context, req resource.Metada
 	resp.TypeName = req.ProviderTypeName + "_" + releaseProfileResourceName
 }
 
-func (r *ReleaseProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *ReleaseProfileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Profiles -->\nRelease Profile resource.\nFor more information refer to [Release Profiles](https://wiki.servarr.com/sonarr/settings#release-profiles) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -113,6 +123,14 @@ func (r *ReleaseProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				ElementType:         types.Int64Type,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -120,12 +138,20 @@ func (r *ReleaseProfileResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *ReleaseProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), indexerReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, releaseProfileResourceName, req.State, resp.Plan)...)
+}
+
 func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var profile *ReleaseProfile
+	var profile *ReleaseProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -133,13 +159,18 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Create resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
 	// Create new ReleaseProfile
-	response, _, err := r.client.ReleaseProfileAPI.CreateReleaseProfile(r.auth).ReleaseProfileResource(*request).Execute()
+	response, _, err := r.client.ReleaseProfileAPI.CreateReleaseProfile(ctx).ReleaseProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, releaseProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, releaseProfileResourceName, err)
 
 		return
 	}
@@ -148,11 +179,12 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var profile *ReleaseProfile
+	var profile *ReleaseProfileResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &profile)...)
 
@@ -160,9 +192,19 @@ func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get releaseprofile current value
-	response, _, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(ctx, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(releaseProfileResourceName, profile.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseProfileResourceName, err))
 
 		return
@@ -172,11 +214,12 @@ func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	// Map response body to resource schema attribute
 	profile.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var profile *ReleaseProfile
+	var profile *ReleaseProfileResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
 
@@ -184,13 +227,25 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, profile.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Build Update resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.ReleaseProfileAPI.GetReleaseProfileById(ctx, int32(profile.ID.ValueInt64())).Execute, nil, releaseProfileResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
 	// Update ReleaseProfile
-	response, _, err := r.client.ReleaseProfileAPI.UpdateReleaseProfile(r.auth, strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
+	response, _, err := r.client.ReleaseProfileAPI.UpdateReleaseProfile(ctx, strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, releaseProfileResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, releaseProfileResourceName, err)
 
 		return
 	}
@@ -199,19 +254,27 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 	// Generate resource state struct
 	profile.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &profile)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *ReleaseProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete releaseprofile current value
-	_, err := r.client.ReleaseProfileAPI.DeleteReleaseProfile(r.auth, int32(ID)).Execute()
+	_, err := r.client.ReleaseProfileAPI.DeleteReleaseProfile(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, releaseProfileResourceName, err))
 
@@ -223,7 +286,7 @@ func (r *ReleaseProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ReleaseProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	releaseProfileImporter(r.client).importState(ctx, r.auth, releaseProfileResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+releaseProfileResourceName+": "+req.ID)
 }
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,6 +22,7 @@ const remotePathMappingResourceName = "remote_path_mapping"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &RemotePathMappingResource{}
+	_ resource.ResourceWithModifyPlan  = &RemotePathMappingResource{}
 	_ resource.ResourceWithImportState = &RemotePathMappingResource{}
 )
 
@@ -30,8 +32,9 @@ func NewRemotePathMappingResource() resource.Resource {
 
 // RemotePathMappingResource defines the remote path mapping implementation.
 type RemotePathMappingResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // RemotePathMapping describes the remote path mapping data model.
@@ -42,6 +45,12 @@ type RemotePathMapping struct {
 	ID         types.Int64  `tfsdk:"id"`
 }
 
+// RemotePathMappingResourceModel describes the remote path mapping resource data model, including timeouts.
+type RemotePathMappingResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	RemotePathMapping
+}
+
 func (r RemotePathMapping) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -52,7 +61,7 @@ func (r RemotePathMapping) getType() attr.Type {
 		})
 }
 
-func (r *RemotePathMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *RemotePathMappingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nRemote Path Mapping resource.\nFor more information refer to [Remote Path Mapping](https://wiki.servarr.com/sonarr/settings#remote-path-mappings) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -76,6 +85,14 @@ func (r *RemotePathMappingResource) Schema(_ context.Context, _ resource.SchemaR
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -87,12 +104,17 @@ func (r *RemotePathMappingResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *RemotePathMappingResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, remotePathMappingResourceName, req.State, resp.Plan)...)
+}
+
 func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var mapping *RemotePathMapping
+	var mapping *RemotePathMappingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &mapping)...)
 
@@ -100,12 +122,15 @@ func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, mapping.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new RemotePathMapping
 	request := mapping.read()
 
-	response, _, err := r.client.RemotePathMappingAPI.CreateRemotePathMapping(r.auth).RemotePathMappingResource(*request).Execute()
+	response, _, err := r.client.RemotePathMappingAPI.CreateRemotePathMapping(ctx).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, remotePathMappingResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, remotePathMappingResourceName, err)
 
 		return
 	}
@@ -118,7 +143,7 @@ func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.Cre
 
 func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var mapping *RemotePathMapping
+	var mapping *RemotePathMappingResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &mapping)...)
 
@@ -126,9 +151,19 @@ func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, mapping.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get remotePathMapping current value
-	response, _, err := r.client.RemotePathMappingAPI.GetRemotePathMappingById(r.auth, int32(mapping.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RemotePathMappingAPI.GetRemotePathMappingById(ctx, int32(mapping.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(remotePathMappingResourceName, mapping.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, remotePathMappingResourceName, err))
 
 		return
@@ -142,7 +177,7 @@ func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadR
 
 func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var mapping *RemotePathMapping
+	var mapping *RemotePathMappingResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &mapping)...)
 
@@ -150,12 +185,22 @@ func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, mapping.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update RemotePathMapping
 	request := mapping.read()
 
-	response, _, err := r.client.RemotePathMappingAPI.UpdateRemotePathMapping(r.auth, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.RemotePathMappingAPI.GetRemotePathMappingById(ctx, int32(mapping.ID.ValueInt64())).Execute, nil, remotePathMappingResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.RemotePathMappingAPI.UpdateRemotePathMapping(ctx, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, remotePathMappingResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, remotePathMappingResourceName, err)
 
 		return
 	}
@@ -167,16 +212,23 @@ func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *RemotePathMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete remotePathMapping current value
-	_, err := r.client.RemotePathMappingAPI.DeleteRemotePathMapping(r.auth, int32(ID)).Execute()
+	_, err := r.client.RemotePathMappingAPI.DeleteRemotePathMapping(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, remotePathMappingResourceName, err))
 
@@ -188,7 +240,7 @@ func (r *RemotePathMappingResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *RemotePathMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	remotePathMappingImporter(r.client).importState(ctx, r.auth, remotePathMappingResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+remotePathMappingResourceName+": "+req.ID)
 }
 
@@ -83,7 +83,7 @@ func (d *RemotePathMappingsDataSource) Configure(ctx context.Context, req dataso
 
 func (d *RemotePathMappingsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get remotePathMappings current value
-	response, _, err := d.client.RemotePathMappingAPI.ListRemotePathMapping(d.auth).Execute()
+	response, _, err := d.client.RemotePathMappingAPI.ListRemotePathMapping(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, remotePathMappingsDataSourceName, err))
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +24,7 @@ const rootFolderResourceName = "root_folder"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &RootFolderResource{}
+	_ resource.ResourceWithModifyPlan  = &RootFolderResource{}
 	_ resource.ResourceWithImportState = &RootFolderResource{}
 )
 
@@ -32,8 +34,9 @@ func NewRootFolderResource() resource.Resource {
 
 // RootFolderResource defines the root folder implementation.
 type RootFolderResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // RootFolder describes the root folder data model.
@@ -44,6 +47,12 @@ type RootFolder struct {
 	Accessible      types.Bool   `tfsdk:"accessible"`
 }
 
+// RootFolderResourceModel describes the root folder resource data model, including timeouts.
+type RootFolderResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	RootFolder
+}
+
 func (r RootFolder) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -72,7 +81,7 @@ func (r *RootFolderResource) Metadata(_ context.Context, req resource.MetadataRe
 	resp.TypeName = req.ProviderTypeName + "_" + rootFolderResourceName
 }
 
-func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *RootFolderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Media Management -->\nRoot Folder resource.\nFor more information refer to [Root Folders](https://wiki.servarr.com/sonarr/settings#root-folders) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -103,6 +112,13 @@ func (r *RootFolderResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -125,12 +141,17 @@ func (r *RootFolderResource) Configure(ctx context.Context, req resource.Configu
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *RootFolderResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, rootFolderResourceName, req.State, resp.Plan)...)
+}
+
 func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var folder *RootFolder
+	var folder *RootFolderResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &folder)...)
 
@@ -138,13 +159,16 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, folder.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new RootFolder
 	request := *sonarr.NewRootFolderResource()
 	request.SetPath(folder.Path.ValueString())
 
-	response, _, err := r.client.RootFolderAPI.CreateRootFolder(r.auth).RootFolderResource(request).Execute()
+	response, _, err := r.client.RootFolderAPI.CreateRootFolder(ctx).RootFolderResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, rootFolderResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, rootFolderResourceName, err)
 
 		return
 	}
@@ -157,7 +181,7 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 
 func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var folder *RootFolder
+	var folder *RootFolderResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &folder)...)
 
@@ -165,9 +189,19 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, folder.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get rootFolder current value
-	response, _, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folder.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RootFolderAPI.GetRootFolderById(ctx, int32(folder.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(rootFolderResourceName, folder.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderResourceName, err))
 
 		return
@@ -184,16 +218,23 @@ func (r *RootFolderResource) Update(_ context.Context, _ resource.UpdateRequest,
 }
 
 func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete rootFolder current value
-	_, err := r.client.RootFolderAPI.DeleteRootFolder(r.auth, int32(ID)).Execute()
+	_, err := r.client.RootFolderAPI.DeleteRootFolder(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, rootFolderResourceName, err))
 
@@ -205,7 +246,7 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *RootFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	rootFolderImporter(r.client).importState(ctx, r.auth, rootFolderResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+rootFolderResourceName+": "+req.ID)
 }
 
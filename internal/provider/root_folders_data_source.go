@@ -95,7 +95,7 @@ func (d *RootFoldersDataSource) Configure(ctx context.Context, req datasource.Co
 
 func (d *RootFoldersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get rootfolders current value
-	response, _, err := d.client.RootFolderAPI.ListRootFolder(d.auth).Execute()
+	response, _, err := d.client.RootFolderAPI.ListRootFolder(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFoldersDataSourceName, err))
 
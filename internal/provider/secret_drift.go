@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// secretHashesKey is the private state key holding the hashes of the secrets last sent to Sonarr.
+const secretHashesKey = "secret_hashes"
+
+// privateState is the resource private state data available in requests and responses.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// storeSecretHashes saves in private state the salted hashes of the secrets sent to Sonarr.
+func storeSecretHashes(ctx context.Context, private privateState, request, response []sonarr.Field) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	secrets, err := helpers.NewSecretHashes(request, response)
+	if err == nil {
+		var value []byte
+
+		if value, err = json.Marshal(secrets); err == nil {
+			return private.SetKey(ctx, secretHashesKey, value)
+		}
+	}
+
+	diags.AddWarning(helpers.SecretDrift, "Unable to store secret hashes, secret drift detection will rely on the test endpoint, got error: "+err.Error())
+
+	return diags
+}
+
+// loadSecretHashes reads from private state the hashes of the secrets last sent to Sonarr.
+func loadSecretHashes(ctx context.Context, private privateState, diags *diag.Diagnostics) *helpers.SecretHashes {
+	secrets := &helpers.SecretHashes{}
+
+	value, localDiags := private.GetKey(ctx, secretHashesKey)
+	diags.Append(localDiags...)
+
+	if len(value) != 0 {
+		if err := json.Unmarshal(value, secrets); err != nil {
+			tflog.Warn(ctx, "unable to read secret hashes: "+err.Error())
+		}
+	}
+
+	return secrets
+}
+
+// checkSecretDrift compares the secrets masked by Sonarr with the ones in state.
+// A secret drifted when its state value is not the one last sent by the provider,
+// or when the test endpoint fails with the server-side secrets but succeeds with the ones in state.
+// Secrets without a stored hash, e.g. of imported resources, are only checked with the test endpoint.
+// Drifted secrets are cleared from the remote fields, so that the next plan sends them again.
+func checkSecretDrift(ctx context.Context, private privateState, state, remote []sonarr.Field, test func([]sonarr.Field) error, resourceName string, diags *diag.Diagnostics) {
+	masked := helpers.MaskedFields(remote)
+	values := helpers.SecretValues(state)
+	secrets := loadSecretHashes(ctx, private, diags)
+
+	var drifted, matching []string
+
+	for name := range masked {
+		value, ok := values[name]
+
+		switch {
+		case !ok:
+			continue
+		case !secrets.Has(name), secrets.Matches(name, value):
+			matching = append(matching, name)
+		default:
+			drifted = append(drifted, name)
+		}
+	}
+
+	if len(drifted) == 0 && len(matching) != 0 {
+		if err := test(remote); err != nil {
+			tflog.Debug(ctx, "test with server-side secrets failed: "+err.Error())
+
+			if test(withSecretValues(remote, masked, values)) == nil {
+				drifted = matching
+			}
+		}
+	}
+
+	if len(drifted) == 0 {
+		return
+	}
+
+	sort.Strings(drifted)
+
+	for i := range remote {
+		for _, name := range drifted {
+			if remote[i].GetName() == name {
+				remote[i].Value = nil
+			}
+		}
+	}
+
+	diags.AddWarning(helpers.SecretDrift, fmt.Sprintf("The %s secrets %s no longer match the configured values and will be sent again on next apply.", resourceName, strings.Join(drifted, ", ")))
+}
+
+// withSecretValues returns a copy of the fields with the masked values replaced by the given ones.
+func withSecretValues(fields []sonarr.Field, masked map[string]bool, values map[string]string) []sonarr.Field {
+	output := make([]sonarr.Field, len(fields))
+
+	for i, f := range fields {
+		if value, ok := values[f.GetName()]; ok && masked[f.GetName()] {
+			f.SetValue(value)
+		}
+
+		output[i] = f
+	}
+
+	return output
+}
+
+// downloadClientSecretTest tests the remote download client with the given fields.
+func downloadClientSecretTest(ctx context.Context, client *sonarr.APIClient, remote sonarr.DownloadClientResource) func([]sonarr.Field) error {
+	return func(fields []sonarr.Field) error {
+		remote.SetFields(fields)
+		_, err := client.DownloadClientAPI.TestDownloadClient(ctx).DownloadClientResource(remote).Execute()
+
+		return err
+	}
+}
+
+// importListSecretTest tests the remote import list with the given fields.
+func importListSecretTest(ctx context.Context, client *sonarr.APIClient, remote sonarr.ImportListResource) func([]sonarr.Field) error {
+	return func(fields []sonarr.Field) error {
+		remote.SetFields(fields)
+		_, err := client.ImportListAPI.TestImportList(ctx).ImportListResource(remote).Execute()
+
+		return err
+	}
+}
+
+// indexerSecretTest tests the remote indexer with the given fields.
+func indexerSecretTest(ctx context.Context, client *sonarr.APIClient, remote sonarr.IndexerResource) func([]sonarr.Field) error {
+	return func(fields []sonarr.Field) error {
+		remote.SetFields(fields)
+		_, err := client.IndexerAPI.TestIndexer(ctx).IndexerResource(remote).Execute()
+
+		return err
+	}
+}
+
+// notificationSecretTest tests the remote notification with the given fields.
+func notificationSecretTest(ctx context.Context, client *sonarr.APIClient, remote sonarr.NotificationResource) func([]sonarr.Field) error {
+	return func(fields []sonarr.Field) error {
+		remote.SetFields(fields)
+		_, err := client.NotificationAPI.TestNotification(ctx).NotificationResource(remote).Execute()
+
+		return err
+	}
+}
+
+// resourceDetectSecretDrift returns whether secret drift detection is enabled.
+func resourceDetectSecretDrift(req resource.ConfigureRequest) bool {
+	if providerData, ok := req.ProviderData.(*SonarrData); ok {
+		return providerData.DetectSecretDrift
+	}
+
+	return false
+}
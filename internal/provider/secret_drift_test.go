@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+var errTestFailed = errors.New("test failed")
+
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+
+	return nil
+}
+
+func TestCheckSecretDrift(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, value interface{}) sonarr.Field {
+		f := sonarr.NewField()
+		f.SetName(name)
+		f.SetValue(value)
+
+		return *f
+	}
+	sent := []sonarr.Field{field("host", "localhost"), field("password", "secret")}
+
+	tests := map[string]struct {
+		test    func([]sonarr.Field) error
+		state   string
+		stored  bool
+		drifted bool
+	}{
+		"matching": {
+			test:    func([]sonarr.Field) error { return nil },
+			state:   "secret",
+			stored:  true,
+			drifted: false,
+		},
+		"imported": {
+			test:    func([]sonarr.Field) error { return nil },
+			state:   "secret",
+			stored:  false,
+			drifted: false,
+		},
+		"imported_changed_on_server": {
+			test: func(fields []sonarr.Field) error {
+				if fields[1].GetValue() == helpers.SensitiveValue {
+					return errTestFailed
+				}
+
+				return nil
+			},
+			state:   "secret",
+			stored:  false,
+			drifted: true,
+		},
+		"changed_in_state": {
+			test:    func([]sonarr.Field) error { return nil },
+			state:   "other",
+			stored:  true,
+			drifted: true,
+		},
+		"changed_on_server": {
+			test: func(fields []sonarr.Field) error {
+				if fields[1].GetValue() == helpers.SensitiveValue {
+					return errTestFailed
+				}
+
+				return nil
+			},
+			state:   "secret",
+			stored:  true,
+			drifted: true,
+		},
+		"service_down": {
+			test:    func([]sonarr.Field) error { return errTestFailed },
+			state:   "secret",
+			stored:  true,
+			drifted: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			ctx := context.Background()
+			private := testPrivateState{}
+			remote := []sonarr.Field{field("host", "localhost"), field("password", helpers.SensitiveValue)}
+
+			if test.stored {
+				assert.False(t, storeSecretHashes(ctx, private, sent, remote).HasError())
+			}
+
+			state := []sonarr.Field{field("host", "localhost"), field("password", test.state)}
+			checkSecretDrift(ctx, private, state, remote, test.test, "test", &diags)
+
+			assert.Equal(t, test.drifted, diags.WarningsCount() == 1)
+			assert.Equal(t, test.drifted, remote[1].GetValue() == nil)
+			assert.Equal(t, "localhost", remote[0].GetValue())
+		})
+	}
+}
@@ -100,7 +100,7 @@ func (d *SeriesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 	// Get series current value
-	response, _, err := d.client.SeriesAPI.ListSeries(d.auth).Execute()
+	response, _, err := d.client.SeriesAPI.ListSeries(requestContext(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesDataSourceName, err))
 
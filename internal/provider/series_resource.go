@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -23,6 +24,7 @@ const seriesResourceName = "series"
 var (
 	_ resource.Resource                = &SeriesResource{}
 	_ resource.ResourceWithImportState = &SeriesResource{}
+	_ resource.ResourceWithModifyPlan  = &SeriesResource{}
 )
 
 func NewSeriesResource() resource.Resource {
@@ -31,8 +33,10 @@ func NewSeriesResource() resource.Resource {
 
 // SeriesResource defines the series implementation.
 type SeriesResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	defaultTags []int64
+	readOnly    bool
 }
 
 // Series describes the series data model.
@@ -50,6 +54,12 @@ type Series struct {
 	UseSceneNumbering types.Bool   `tfsdk:"use_scene_numbering"`
 }
 
+// SeriesResourceModel describes the series resource data model, including timeouts.
+type SeriesResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Series
+}
+
 func (s Series) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -93,7 +103,7 @@ func (r *SeriesResource) Metadata(_ context.Context, req resource.MetadataReques
 	resp.TypeName = req.ProviderTypeName + "_" + seriesResourceName
 }
 
-func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *SeriesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	// TODO: waiting to implement seasons and images until empty conversion is managed natively https://www.terraform.io/plugin/framework/accessing-values#conversion-rules
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Series -->\nSeries resource.\nFor more information refer to [Series](https://wiki.servarr.com/sonarr/library#series) documentation.",
@@ -148,6 +158,14 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -155,12 +173,20 @@ func (r *SeriesResource) Configure(ctx context.Context, req resource.ConfigureRe
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.defaultTags = resourceDefaultTags(req)
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *SeriesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	applyDefaultTags(ctx, r.defaultTags, req, resp)
+	checkReferences(ctx, r.auth, req, resp, tagsReference(r.client), qualityProfileReference(r.client))
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, seriesResourceName, req.State, resp.Plan)...)
+}
+
 func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var series *Series
+	var series *SeriesResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &series)...)
 
@@ -168,6 +194,9 @@ func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, series.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new Series
 	request := series.read(ctx, &resp.Diagnostics)
 	// TODO: can parametrize AddSeriesOptions
@@ -179,9 +208,11 @@ func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	request.SetAddOptions(*options)
 
-	response, _, err := r.client.SeriesAPI.CreateSeries(r.auth).SeriesResource(*request).Execute()
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
+	response, _, err := r.client.SeriesAPI.CreateSeries(ctx).SeriesResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, seriesResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, seriesResourceName, err)
 
 		return
 	}
@@ -190,11 +221,12 @@ func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Generate resource state struct
 	series.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var series *Series
+	var series *SeriesResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &series)...)
 
@@ -202,9 +234,19 @@ func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, series.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get series current value
-	response, _, err := r.client.SeriesAPI.GetSeriesById(r.auth, int32(series.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.SeriesAPI.GetSeriesById(ctx, int32(series.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(seriesResourceName, series.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
 
 		return
@@ -214,11 +256,12 @@ func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Map response body to resource schema attribute
 	series.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
+	removeDefaultTags(ctx, r.defaultTags, req.State, &resp.State, &resp.Diagnostics)
 }
 
 func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var series *Series
+	var series *SeriesResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &series)...)
 
@@ -226,13 +269,25 @@ func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, series.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update Series
 	request := series.read(ctx, &resp.Diagnostics)
 
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.SeriesAPI.GetSeriesById(ctx, int32(series.ID.ValueInt64())).Execute, nil, seriesResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request.Tags = addDefaultTags(r.defaultTags, request.Tags)
+
 	// TODO: manage movefiles on sdk
-	response, _, err := r.client.SeriesAPI.UpdateSeries(r.auth, strconv.Itoa(int(request.GetId()))).SeriesResource(*request).Execute()
+	response, _, err := r.client.SeriesAPI.UpdateSeries(ctx, strconv.Itoa(int(request.GetId()))).SeriesResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, seriesResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, seriesResourceName, err)
 
 		return
 	}
@@ -241,20 +296,28 @@ func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Map response body to resource schema attribute
 	series.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
+	removeDefaultTags(ctx, r.defaultTags, req.Plan, &resp.State, &resp.Diagnostics)
 }
 
 func (r *SeriesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete series current value
 	// TODO: manage delete parameters on SDK
-	_, err := r.client.SeriesAPI.DeleteSeries(r.auth, int32(ID)).Execute()
+	_, err := r.client.SeriesAPI.DeleteSeries(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, seriesResourceName, err))
 
@@ -266,7 +329,7 @@ func (r *SeriesResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SeriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	seriesImporter(r.client).importState(ctx, r.auth, seriesResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+seriesResourceName+": "+req.ID)
 }
 
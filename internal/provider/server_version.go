@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// versionRequirement describes the minimum Sonarr version needed by a resource or, if set, by one of its attributes.
+type versionRequirement struct {
+	attribute string
+	minimum   string
+}
+
+// notificationVersionRequirements lists the notification attributes not supported by every Sonarr v4 version.
+var notificationVersionRequirements = []versionRequirement{
+	{attribute: "on_import_complete", minimum: "4.0.10"},
+}
+
+// delayProfileVersionRequirements lists the delay profile attributes not supported by every Sonarr v4 version.
+var delayProfileVersionRequirements = []versionRequirement{
+	{attribute: "minimum_custom_format_score", minimum: "4.0.5"},
+}
+
+// autoTagVersionRequirements sets the minimum Sonarr version supporting auto tagging.
+var autoTagVersionRequirements = []versionRequirement{
+	{minimum: "4.0.0"},
+}
+
+// parseServerVersion parses the version reported by Sonarr, returning nil if invalid.
+func parseServerVersion(ctx context.Context, raw string) *version.Version {
+	serverVersion, err := version.NewVersion(raw)
+	if err != nil {
+		tflog.Debug(ctx, "unable to parse Sonarr version: "+raw)
+
+		return nil
+	}
+
+	return serverVersion
+}
+
+// checkRequiredVersion validates the server version against the provider version constraint.
+func checkRequiredVersion(serverVersion *version.Version, requiredVersion string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	constraints, err := version.NewConstraint(requiredVersion)
+	if err != nil {
+		diags.AddAttributeError(path.Root("required_version"), helpers.UnsupportedServerVersion, fmt.Sprintf("Invalid version constraint '%s': %s", requiredVersion, err))
+
+		return diags
+	}
+
+	if serverVersion == nil {
+		diags.AddAttributeError(path.Root("required_version"), helpers.UnsupportedServerVersion, "Unable to detect Sonarr version to check the required version constraint.")
+
+		return diags
+	}
+
+	if !constraints.Check(serverVersion) {
+		diags.AddAttributeError(path.Root("required_version"), helpers.UnsupportedServerVersion, fmt.Sprintf("Sonarr version %s does not satisfy the required version constraint '%s'.", serverVersion, requiredVersion))
+	}
+
+	return diags
+}
+
+// checkServerVersion reports the resource and the configured attributes which are not supported by the server version.
+// No check is performed if the server version is unknown.
+func checkServerVersion(ctx context.Context, serverVersion *version.Version, config tfsdk.Config, resourceName string, requirements []versionRequirement) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if serverVersion == nil || config.Raw.IsNull() {
+		return diags
+	}
+
+	for _, requirement := range requirements {
+		minimum := version.Must(version.NewVersion(requirement.minimum))
+		if !serverVersion.LessThan(minimum) {
+			continue
+		}
+
+		if requirement.attribute == "" {
+			diags.AddError(helpers.UnsupportedServerVersion, fmt.Sprintf("%s requires Sonarr %s or later, got %s.", resourceName, minimum, serverVersion))
+
+			continue
+		}
+
+		var value attr.Value
+
+		diags.Append(config.GetAttribute(ctx, path.Root(requirement.attribute), &value)...)
+
+		if value != nil && !value.IsNull() {
+			diags.AddAttributeError(path.Root(requirement.attribute), helpers.UnsupportedServerVersion, fmt.Sprintf("%s.%s requires Sonarr %s or later, got %s.", resourceName, requirement.attribute, minimum, serverVersion))
+		}
+	}
+
+	return diags
+}
+
+// resourceServerVersion returns the Sonarr version detected by the provider, if any.
+func resourceServerVersion(req resource.ConfigureRequest) *version.Version {
+	if providerData, ok := req.ProviderData.(*SonarrData); ok {
+		return providerData.Version
+	}
+
+	return nil
+}
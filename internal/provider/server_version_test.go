@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRequiredVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		version    *version.Version
+		constraint string
+		err        bool
+	}{
+		"valid": {
+			version:    version.Must(version.NewVersion("4.0.10.2544")),
+			constraint: ">= 4.0.0, < 5.0.0",
+			err:        false,
+		},
+		"too_old": {
+			version:    version.Must(version.NewVersion("3.0.10.1567")),
+			constraint: ">= 4.0.0",
+			err:        true,
+		},
+		"unknown_version": {
+			version:    nil,
+			constraint: ">= 4.0.0",
+			err:        true,
+		},
+		"invalid_constraint": {
+			version:    version.Must(version.NewVersion("4.0.10.2544")),
+			constraint: "four",
+			err:        true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.err, checkRequiredVersion(test.version, test.constraint).HasError())
+		})
+	}
+}
+
+func TestCheckServerVersion(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"on_import_complete": schema.BoolAttribute{Optional: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"on_import_complete": tftypes.Bool}}
+
+	tests := map[string]struct {
+		version      *version.Version
+		value        interface{}
+		requirements []versionRequirement
+		err          bool
+	}{
+		"supported": {
+			version:      version.Must(version.NewVersion("4.0.10.2544")),
+			value:        true,
+			requirements: notificationVersionRequirements,
+			err:          false,
+		},
+		"unsupported_attribute": {
+			version:      version.Must(version.NewVersion("4.0.9.2244")),
+			value:        true,
+			requirements: notificationVersionRequirements,
+			err:          true,
+		},
+		"unset_attribute": {
+			version:      version.Must(version.NewVersion("4.0.9.2244")),
+			value:        nil,
+			requirements: notificationVersionRequirements,
+			err:          false,
+		},
+		"unsupported_resource": {
+			version:      version.Must(version.NewVersion("3.0.10.1567")),
+			value:        nil,
+			requirements: autoTagVersionRequirements,
+			err:          true,
+		},
+		"unknown_version": {
+			version:      nil,
+			value:        true,
+			requirements: notificationVersionRequirements,
+			err:          false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Schema: testSchema,
+				Raw:    tftypes.NewValue(objectType, map[string]tftypes.Value{"on_import_complete": tftypes.NewValue(tftypes.Bool, test.value)}),
+			}
+
+			assert.Equal(t, test.err, checkServerVersion(context.Background(), test.version, config, "test", test.requirements).HasError())
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stateUpgrade describes the attribute changes from a prior schema version to the current one.
+type stateUpgrade struct {
+	// aliases maps prior attribute names, kept as deprecated aliases, to the current ones.
+	// Values are copied unless the current attribute is already set, and kept in the alias,
+	// so that configurations still using the alias do not plan an update.
+	aliases map[string]string
+}
+
+// stateUpgrader upgrades the raw state of a prior schema version to the current schema of the resource.
+// Attributes no longer in the schema are dropped and new ones are null.
+func stateUpgrader(ctx context.Context, r resource.Resource, upgrade stateUpgrade) resource.StateUpgrader {
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	current := schemaResp.Schema
+
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			state := make(map[string]interface{})
+			if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+				resp.Diagnostics.AddError(helpers.StateUpgradeError, fmt.Sprintf("Unable to read prior state, got error: %s", err))
+
+				return
+			}
+
+			upgrade.apply(state)
+
+			body, err := json.Marshal(state)
+			if err != nil {
+				resp.Diagnostics.AddError(helpers.StateUpgradeError, fmt.Sprintf("Unable to write upgraded state, got error: %s", err))
+
+				return
+			}
+
+			value, err := tfprotov6.RawState{JSON: body}.UnmarshalWithOpts(current.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+				ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+			})
+			if err != nil {
+				resp.Diagnostics.AddError(helpers.StateUpgradeError, fmt.Sprintf("Unable to convert upgraded state, got error: %s", err))
+
+				return
+			}
+
+			resp.State = tfsdk.State{Schema: current, Raw: value}
+		},
+	}
+}
+
+// apply changes the raw state in place.
+func (u stateUpgrade) apply(state map[string]interface{}) {
+	for alias, name := range u.aliases {
+		if value := state[alias]; value != nil && state[name] == nil {
+			state[name] = value
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadClientStateUpgrade(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		resource resource.ResourceWithUpgradeState
+		state    string
+		expected int64
+		alias    bool
+	}{
+		"typed": {
+			resource: &DownloadClientUtorrentResource{},
+			state:    `{"id":1,"name":"uTorrent","host":"utorrent","intial_state":2}`,
+			expected: 2,
+			alias:    true,
+		},
+		"generic": {
+			resource: &DownloadClientResource{},
+			state:    `{"id":1,"name":"uTorrent","implementation":"UTorrent","intial_state":3,"initial_state":null}`,
+			expected: 3,
+			alias:    true,
+		},
+		"generic merged": {
+			resource: &DownloadClientResource{},
+			state:    `{"id":1,"name":"Deluge","implementation":"Deluge","intial_state":null,"initial_state":1}`,
+			expected: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			upgrader := test.resource.UpgradeState(ctx)[0]
+			req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(test.state)}}
+			resp := resource.UpgradeStateResponse{}
+
+			upgrader.StateUpgrader(ctx, req, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+
+			var initialState, intialState types.Int64
+
+			resp.State.GetAttribute(ctx, path.Root("initial_state"), &initialState)
+			resp.State.GetAttribute(ctx, path.Root("intial_state"), &intialState)
+			assert.Equal(t, test.expected, initialState.ValueInt64())
+			// The deprecated attribute keeps its value, so that configurations still using it do not plan an update.
+			assert.Equal(t, test.alias, !intialState.IsNull())
+
+			if test.alias {
+				assert.Equal(t, test.expected, intialState.ValueInt64())
+			}
+		})
+	}
+}
+
+func TestDownloadClientIntialStateAlias(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		implementation string
+		field          string
+	}{
+		"utorrent": {
+			implementation: downloadClientUtorrentImplementation,
+			field:          "intialState",
+		},
+		"deluge": {
+			implementation: downloadClientDelugeImplementation,
+			field:          "initialState",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := DownloadClient{
+				Implementation:        types.StringValue(test.implementation),
+				Tags:                  types.SetValueMust(types.Int64Type, nil),
+				InitialState:          types.Int64Unknown(),
+				DeprecatedIntialState: types.Int64Value(3),
+			}
+
+			var diags diag.Diagnostics
+
+			fields := client.read(ctx, &diags).GetFields()
+			assert.False(t, diags.HasError())
+			assert.Len(t, fields, 1)
+			assert.Equal(t, test.field, fields[0].GetName())
+			assert.Equal(t, int64(3), fields[0].GetValue())
+		})
+	}
+}
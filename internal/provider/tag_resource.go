@@ -8,6 +8,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -25,6 +26,7 @@ const tagResourceName = "tag"
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &TagResource{}
+	_ resource.ResourceWithModifyPlan  = &TagResource{}
 	_ resource.ResourceWithImportState = &TagResource{}
 )
 
@@ -34,8 +36,9 @@ func NewTagResource() resource.Resource {
 
 // TagResource defines the tag implementation.
 type TagResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client   *sonarr.APIClient
+	auth     context.Context
+	readOnly bool
 }
 
 // Tag describes the tag data model.
@@ -44,6 +47,12 @@ type Tag struct {
 	ID    types.Int64  `tfsdk:"id"`
 }
 
+// TagResourceModel describes the tag resource data model, including timeouts.
+type TagResourceModel struct {
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Tag
+}
+
 func (t Tag) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -56,7 +65,7 @@ func (r *TagResource) Metadata(_ context.Context, req resource.MetadataRequest,
 	resp.TypeName = req.ProviderTypeName + "_" + tagResourceName
 }
 
-func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *TagResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Tags -->\nTag resource.\nFor more information refer to [Tags](https://wiki.servarr.com/sonarr/settings#tags) documentation.",
 		Attributes: map[string]schema.Attribute{
@@ -78,6 +87,14 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -85,12 +102,17 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.readOnly = resourceReadOnly(req)
 	}
 }
 
+func (r *TagResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(checkReadOnly(r.readOnly, tagResourceName, req.State, resp.Plan)...)
+}
+
 func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var tag *Tag
+	var tag *TagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -98,13 +120,16 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, tag.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Create new Tag
 	request := *sonarr.NewTagResource()
 	request.SetLabel(tag.Label.ValueString())
 
-	response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(request).Execute()
+	response, _, err := r.client.TagAPI.CreateTag(ctx).TagResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Create, tagResourceName, err)
 
 		return
 	}
@@ -117,7 +142,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var tag *Tag
+	var tag *TagResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &tag)...)
 
@@ -125,9 +150,19 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, tag.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// Get tag current value
-	response, _, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.TagAPI.GetTagById(ctx, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
+		if helpers.IsNotFound(httpResp) {
+			tflog.Warn(ctx, helpers.ParseRemovedFromState(tagResourceName, tag.ID.ValueInt64()))
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
 
 		return
@@ -141,7 +176,7 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var tag *Tag
+	var tag *TagResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &tag)...)
 
@@ -149,14 +184,24 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, tag.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update Tag
-	tagResource := *sonarr.NewTagResource()
-	tagResource.SetLabel(tag.Label.ValueString())
-	tagResource.SetId(int32(tag.ID.ValueInt64()))
+	request := sonarr.NewTagResource()
+	request.SetLabel(tag.Label.ValueString())
+	request.SetId(int32(tag.ID.ValueInt64()))
+
+	// Keep the remote value of settings not managed by the provider
+	request = mergeRemote(request, r.client.TagAPI.GetTagById(ctx, int32(tag.ID.ValueInt64())).Execute, nil, tagResourceName, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	response, _, err := r.client.TagAPI.UpdateTag(r.auth, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
+	response, _, err := r.client.TagAPI.UpdateTag(ctx, fmt.Sprint(request.GetId())).TagResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
+		helpers.AddClientError(ctx, &resp.Diagnostics, req.Plan, helpers.Fields{}, helpers.Update, tagResourceName, err)
 
 		return
 	}
@@ -168,16 +213,23 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 }
 
 func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var (
+		ID      int64
+		timeout timeouts.Value
+	)
 
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeout)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := timeoutContext(ctx, r.auth, timeout.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Delete tag current value
-	_, err := r.client.TagAPI.DeleteTag(r.auth, int32(ID)).Execute()
+	_, err := r.client.TagAPI.DeleteTag(ctx, int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, tagResourceName, err))
 
@@ -189,7 +241,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tagImporter(r.client).importState(ctx, r.auth, tagResourceName, req, resp)
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -41,6 +43,25 @@ func TestAccTagResource(t *testing.T) {
 					resource.TestCheckResourceAttr("sonarr_tag.test", "label", "1080p"),
 				),
 			},
+			// Out of band deletion testing
+			{
+				Config: testAccTagResourceConfig("test", "1080p"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckResourceDisappears("sonarr_tag.test", func(ctx context.Context, client *sonarr.APIClient, id int32) error {
+						_, err := client.TagAPI.DeleteTag(ctx, id).Execute()
+
+						return err
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			// Re-create after out of band deletion
+			{
+				Config: testAccTagResourceConfig("test", "1080p"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_tag.test", "id"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "sonarr_tag.test",
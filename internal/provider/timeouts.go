@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// defaultTimeout is used for resource operations without a configured timeout.
+const defaultTimeout = 20 * time.Minute
+
+// requestContext returns the Terraform request context carrying the Sonarr authentication,
+// so that cancellation and deadlines are propagated to the API calls.
+func requestContext(ctx, auth context.Context) context.Context {
+	if auth == nil {
+		return ctx
+	}
+
+	ctx = context.WithValue(ctx, sonarr.ContextAPIKeys, auth.Value(sonarr.ContextAPIKeys))
+
+	return context.WithValue(ctx, sonarr.ContextServerVariables, auth.Value(sonarr.ContextServerVariables))
+}
+
+// timeoutContext returns the request context bounded by the configured operation timeout.
+func timeoutContext(
+	ctx, auth context.Context,
+	timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+	diags *diag.Diagnostics,
+) (context.Context, context.CancelFunc) {
+	duration, timeoutDiags := timeout(ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+
+	return context.WithTimeout(requestContext(ctx, auth), duration)
+}
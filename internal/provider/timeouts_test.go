@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutContext(t *testing.T) {
+	t.Parallel()
+
+	auth := context.WithValue(context.Background(), sonarr.ContextAPIKeys, map[string]sonarr.APIKey{"X-Api-Key": {Key: "key"}})
+	timeout := func(_ context.Context, _ time.Duration) (time.Duration, diag.Diagnostics) {
+		return time.Minute, nil
+	}
+
+	var diags diag.Diagnostics
+
+	parent, cancelParent := context.WithCancel(context.Background())
+	ctx, cancel := timeoutContext(parent, auth, timeout, &diags)
+
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+	assert.Equal(t, auth.Value(sonarr.ContextAPIKeys), ctx.Value(sonarr.ContextAPIKeys))
+	assert.False(t, diags.HasError())
+
+	cancelParent()
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
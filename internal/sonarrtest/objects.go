@@ -0,0 +1,213 @@
+package sonarrtest
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+)
+
+// collectionKinds are the API endpoints serving a collection of objects.
+var collectionKinds = []string{
+	"autotagging",
+	"customformat",
+	"delayprofile",
+	"downloadclient",
+	"importlist",
+	"importlistexclusion",
+	"indexer",
+	"language",
+	"metadata",
+	"notification",
+	"qualitydefinition",
+	"qualityprofile",
+	"releaseprofile",
+	"remotepathmapping",
+	"rootfolder",
+	"series",
+	"tag",
+}
+
+// configKinds are the API endpoints serving a configuration singleton (e.g. `config/host`).
+var configKinds = []string{"downloadclient", "host", "importlist", "indexer", "mediamanagement", "naming", "ui"}
+
+// namedKinds are the collections whose objects require a unique name.
+var namedKinds = []string{
+	"autotagging",
+	"customformat",
+	"downloadclient",
+	"importlist",
+	"indexer",
+	"metadata",
+	"notification",
+	"qualityprofile",
+}
+
+// qualities returns the Sonarr qualities, ordered by weight.
+func qualities() []object {
+	return []object{
+		{"id": 0, "name": "Unknown", "source": "unknown", "resolution": 0},
+		{"id": 1, "name": "SDTV", "source": "television", "resolution": 480},
+		{"id": 12, "name": "WEBRip-480p", "source": "webRip", "resolution": 480},
+		{"id": 8, "name": "WEBDL-480p", "source": "web", "resolution": 480},
+		{"id": 2, "name": "DVD", "source": "dvd", "resolution": 480},
+		{"id": 13, "name": "Bluray-480p", "source": "bluray", "resolution": 480},
+		{"id": 22, "name": "Bluray-576p", "source": "bluray", "resolution": 576},
+		{"id": 4, "name": "HDTV-720p", "source": "television", "resolution": 720},
+		{"id": 9, "name": "HDTV-1080p", "source": "television", "resolution": 1080},
+		{"id": 10, "name": "Raw-HD", "source": "televisionRaw", "resolution": 1080},
+		{"id": 14, "name": "WEBRip-720p", "source": "webRip", "resolution": 720},
+		{"id": 5, "name": "WEBDL-720p", "source": "web", "resolution": 720},
+		{"id": 6, "name": "Bluray-720p", "source": "bluray", "resolution": 720},
+		{"id": 15, "name": "WEBRip-1080p", "source": "webRip", "resolution": 1080},
+		{"id": 3, "name": "WEBDL-1080p", "source": "web", "resolution": 1080},
+		{"id": 7, "name": "Bluray-1080p", "source": "bluray", "resolution": 1080},
+		{"id": 20, "name": "Bluray-1080p Remux", "source": "blurayRaw", "resolution": 1080},
+		{"id": 16, "name": "HDTV-2160p", "source": "television", "resolution": 2160},
+		{"id": 17, "name": "WEBRip-2160p", "source": "webRip", "resolution": 2160},
+		{"id": 18, "name": "WEBDL-2160p", "source": "web", "resolution": 2160},
+		{"id": 19, "name": "Bluray-2160p", "source": "bluray", "resolution": 2160},
+		{"id": 21, "name": "Bluray-2160p Remux", "source": "blurayRaw", "resolution": 2160},
+	}
+}
+
+// fixtures returns the objects Sonarr creates on installation.
+func fixtures() map[string][]object {
+	definitions := make([]object, 0, len(qualities()))
+	for i, quality := range qualities() {
+		definitions = append(definitions, object{"title": quality["name"], "weight": i + 1, "quality": quality})
+	}
+
+	// profile allows the qualities of the given resolutions.
+	profile := func(name string, cutoff int, resolutions ...int64) object {
+		items := make([]object, 0, len(qualities()))
+		for _, quality := range qualities() {
+			items = append(items, object{
+				"quality": quality,
+				"items":   []object{},
+				"allowed": slices.Contains(resolutions, toInt(quality["resolution"])),
+			})
+		}
+
+		return object{
+			"name":              name,
+			"upgradeAllowed":    false,
+			"cutoff":            cutoff,
+			"items":             items,
+			"minFormatScore":    0,
+			"cutoffFormatScore": 0,
+			"formatItems":       []object{},
+		}
+	}
+
+	return map[string][]object{
+		"delayprofile": {{
+			"enableUsenet":      true,
+			"enableTorrent":     true,
+			"preferredProtocol": "usenet",
+			"usenetDelay":       0,
+			"torrentDelay":      0,
+			"order":             math.MaxInt32,
+			"tags":              []int{},
+		}},
+		"language": {
+			{"name": "Unknown", "nameLower": "unknown"},
+			{"name": "English", "nameLower": "english"},
+			{"name": "French", "nameLower": "french"},
+		},
+		"qualitydefinition": definitions,
+		"qualityprofile": {
+			profile("Any", 1, 480, 576, 720, 1080, 2160),
+			profile("SD", 1, 480, 576),
+			profile("HD-720p", 4, 720),
+			profile("HD-1080p", 9, 1080),
+			profile("Ultra-HD", 16, 2160),
+			profile("HD - 720p/1080p", 4, 720, 1080),
+		},
+	}
+}
+
+// validate returns the validation failures of the object, in the Sonarr format.
+func (c *collection) validate(kind string, item object) []object {
+	field, property := "name", "Name"
+
+	switch {
+	case kind == "tag":
+		field, property = "label", "Label"
+	case !slices.Contains(namedKinds, kind):
+		return nil
+	}
+
+	name, _ := item[field].(string)
+	if name == "" {
+		return []object{failure(property, "'"+property+"' must not be empty.")}
+	}
+
+	if kind == "tag" {
+		return nil
+	}
+
+	for id, existing := range c.items {
+		if other, _ := existing[field].(string); id != toInt(item["id"]) && strings.EqualFold(other, name) {
+			return []object{failure(property, "Should be unique")}
+		}
+	}
+
+	return nil
+}
+
+func failure(property, message string) object {
+	return object{"propertyName": property, "errorMessage": message, "severity": "error"}
+}
+
+// mask returns a copy of the object with the secret fields masked.
+func mask(item object) object {
+	output := make(object)
+
+	body, _ := json.Marshal(item)
+	_ = json.Unmarshal(body, &output)
+
+	for _, field := range objectFields(output) {
+		if value, _ := field["value"].(string); value != "" && isSecret(field) {
+			field["value"] = sensitiveValue
+		}
+	}
+
+	return output
+}
+
+// keepSecrets replaces the masked secrets sent back by clients with the stored ones.
+func keepSecrets(item, stored object) {
+	previous := make(map[interface{}]interface{})
+	for _, field := range objectFields(stored) {
+		previous[field["name"]] = field["value"]
+	}
+
+	for _, field := range objectFields(item) {
+		if field["value"] == sensitiveValue && isSecret(field) {
+			field["value"] = previous[field["name"]]
+		}
+	}
+}
+
+// objectFields returns the provider fields of the object (e.g. download client settings).
+func objectFields(item object) []object {
+	fields, _ := item["fields"].([]interface{})
+	output := make([]object, 0, len(fields))
+
+	for _, f := range fields {
+		if field, ok := f.(object); ok {
+			output = append(output, field)
+		}
+	}
+
+	return output
+}
+
+func isSecret(field object) bool {
+	name, _ := field["name"].(string)
+
+	return slices.Contains(helpers.SensitiveFields, name)
+}
@@ -0,0 +1,335 @@
+// Package sonarrtest implements an in-memory emulator of the Sonarr v3 API routes used by the provider,
+// so that provider tests can run without a live Sonarr instance.
+package sonarrtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+)
+
+const (
+	// APIKey is the API key accepted by the emulator.
+	APIKey = "sonarrtest"
+	// Version is the Sonarr version reported by the emulator.
+	Version = "4.0.10.2544"
+	// sensitiveValue is the placeholder returned by Sonarr instead of secrets.
+	sensitiveValue = "********"
+	apiPath        = "/api/v3/"
+)
+
+type object = map[string]interface{}
+
+// Server is an in-memory Sonarr API served by a httptest.Server.
+// Objects are stored as sent, with IDs allocated on creation, secrets masked in responses
+// and Sonarr validation failures returned for missing or duplicated names.
+type Server struct {
+	*httptest.Server
+	collections map[string]*collection
+	configs     map[string]object
+	mu          sync.Mutex
+}
+
+type collection struct {
+	items  map[int64]object
+	nextID int64
+}
+
+// NewServer starts an emulator seeded with the objects Sonarr creates on installation.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		collections: make(map[string]*collection),
+		configs:     make(map[string]object),
+	}
+
+	for _, kind := range collectionKinds {
+		s.collections[kind] = &collection{items: make(map[int64]object), nextID: 1}
+	}
+
+	for _, kind := range configKinds {
+		s.configs[kind] = object{"id": 1}
+	}
+
+	for kind, items := range fixtures() {
+		for _, item := range items {
+			s.collections[kind].add(item)
+		}
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Client returns a Sonarr client authenticated against the emulator.
+func (s *Server) Client() *sonarr.APIClient {
+	config := sonarr.NewConfiguration()
+	config.AddDefaultHeader("X-Api-Key", APIKey)
+	config.Servers = sonarr.ServerConfigurations{{URL: s.URL}}
+
+	return sonarr.NewAPIClient(config)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/ping" {
+		writeJSON(w, http.StatusOK, object{"status": "OK"})
+
+		return
+	}
+
+	if r.Header.Get("X-Api-Key") != APIKey && r.URL.Query().Get("apikey") != APIKey {
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	index := strings.Index(r.URL.Path, apiPath)
+	if index < 0 {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path[index+len(apiPath):], "/"), "/")
+
+	switch {
+	case len(segments) == 2 && segments[0] == "system" && segments[1] == "status":
+		writeJSON(w, http.StatusOK, object{
+			"appName":      "Sonarr",
+			"instanceName": "Sonarr",
+			"version":      Version,
+			"urlBase":      "",
+			"isProduction": true,
+			"isLinux":      true,
+			"osName":       "linux",
+			"branch":       "main",
+			"mode":         "console",
+		})
+	case segments[0] == "config" && len(segments) > 1:
+		s.serveConfig(w, r, segments[1])
+	case len(segments) == 2 && segments[0] == "series" && segments[1] == "lookup":
+		s.serveLookup(w, r)
+	case len(segments) == 2 && segments[1] == "test" && r.Method == http.MethodPost:
+		s.serveTest(w, r, segments[0])
+	case len(segments) == 2 && segments[1] == "schema":
+		writeJSON(w, http.StatusOK, []object{})
+	default:
+		s.serveCollection(w, r, segments)
+	}
+}
+
+// serveConfig serves the singleton configuration endpoints (e.g. `config/host`).
+func (s *Server) serveConfig(w http.ResponseWriter, r *http.Request, kind string) {
+	config, ok := s.configs[kind]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		body, ok := readObject(w, r)
+		if !ok {
+			return
+		}
+
+		for key, value := range body {
+			if key != "id" {
+				config[key] = value
+			}
+		}
+	}
+
+	writeJSON(w, http.StatusOK, config)
+}
+
+// serveLookup returns a series for the searched TVDB ID.
+func (s *Server) serveLookup(w http.ResponseWriter, r *http.Request) {
+	term := strings.TrimPrefix(r.URL.Query().Get("term"), "tvdb:")
+
+	tvdbID, err := strconv.Atoi(term)
+	if err != nil {
+		writeJSON(w, http.StatusOK, []object{})
+
+		return
+	}
+
+	for _, series := range s.collections["series"].items {
+		if toInt(series["tvdbId"]) == int64(tvdbID) {
+			writeJSON(w, http.StatusOK, []object{series})
+
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, []object{{"title": "Series " + term, "tvdbId": tvdbID, "seasons": []object{}}})
+}
+
+// serveTest validates the object as the Sonarr test endpoints do.
+func (s *Server) serveTest(w http.ResponseWriter, r *http.Request, kind string) {
+	c, ok := s.collections[kind]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	if failures := c.validate(kind, body); len(failures) != 0 {
+		writeJSON(w, http.StatusBadRequest, failures)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveCollection serves the CRUD endpoints of a collection (e.g. `tag` and `tag/{id}`).
+func (s *Server) serveCollection(w http.ResponseWriter, r *http.Request, segments []string) {
+	c, ok := s.collections[segments[0]]
+	if !ok || len(segments) > 2 {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	kind := segments[0]
+
+	if len(segments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, c.list())
+		case http.MethodPost:
+			s.create(w, r, kind, c)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+
+		return
+	}
+
+	id, err := strconv.ParseInt(segments[1], 10, 64)
+	if err != nil || c.items[id] == nil {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, mask(c.items[id]))
+	case http.MethodPut:
+		s.update(w, r, kind, c, id)
+	case http.MethodDelete:
+		delete(c.items, id)
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, kind string, c *collection) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	delete(body, "id")
+
+	if failures := c.validate(kind, body); len(failures) != 0 {
+		writeJSON(w, http.StatusBadRequest, failures)
+
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mask(c.add(body)))
+}
+
+func (s *Server) update(w http.ResponseWriter, r *http.Request, kind string, c *collection, id int64) {
+	body, ok := readObject(w, r)
+	if !ok {
+		return
+	}
+
+	body["id"] = id
+	keepSecrets(body, c.items[id])
+
+	if failures := c.validate(kind, body); len(failures) != 0 {
+		writeJSON(w, http.StatusBadRequest, failures)
+
+		return
+	}
+
+	c.items[id] = body
+	writeJSON(w, http.StatusAccepted, mask(body))
+}
+
+// add stores the object with a new ID.
+func (c *collection) add(item object) object {
+	item["id"] = c.nextID
+	c.items[c.nextID] = item
+	c.nextID++
+
+	return item
+}
+
+// list returns the masked objects ordered by ID.
+func (c *collection) list() []object {
+	ids := make([]int64, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	output := make([]object, len(ids))
+	for i, id := range ids {
+		output[i] = mask(c.items[id])
+	}
+
+	return output
+}
+
+func readObject(w http.ResponseWriter, r *http.Request) (object, bool) {
+	body := make(object)
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeJSON(w, http.StatusBadRequest, []object{{"propertyName": "", "errorMessage": err.Error(), "severity": "error"}})
+
+		return nil, false
+	}
+
+	return body, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func toInt(value interface{}) int64 {
+	switch v := value.(type) {
+	case float64:
+		return int64(v)
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,149 @@
+package sonarrtest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerCollection(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	tag := sonarr.NewTagResource()
+	tag.SetLabel("test")
+
+	created, _, err := client.TagAPI.CreateTag(ctx).TagResource(*tag).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), created.GetId())
+
+	created.SetLabel("updated")
+	_, _, err = client.TagAPI.UpdateTag(ctx, "1").TagResource(*created).Execute()
+	assert.NoError(t, err)
+
+	read, _, err := client.TagAPI.GetTagById(ctx, 1).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "updated", read.GetLabel())
+
+	_, err = client.TagAPI.DeleteTag(ctx, 1).Execute()
+	assert.NoError(t, err)
+
+	_, httpResp, err := client.TagAPI.GetTagById(ctx, 1).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, httpResp.StatusCode)
+
+	// IDs are not reused
+	created, _, err = client.TagAPI.CreateTag(ctx).TagResource(*tag).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), created.GetId())
+
+	tags, _, err := client.TagAPI.ListTag(ctx).Execute()
+	assert.NoError(t, err)
+	assert.Len(t, tags, 1)
+}
+
+func TestServerSecrets(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+	field := func(name string, value interface{}) sonarr.Field {
+		f := sonarr.NewField()
+		f.SetName(name)
+		f.SetValue(value)
+
+		return *f
+	}
+
+	downloadClient := sonarr.NewDownloadClientResource()
+	downloadClient.SetName("Transmission")
+	downloadClient.SetFields([]sonarr.Field{field("host", "localhost"), field("password", "secret")})
+
+	created, _, err := client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*downloadClient).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", created.GetFields()[0].GetValue())
+	assert.Equal(t, sensitiveValue, created.GetFields()[1].GetValue())
+
+	// Masked secrets sent back keep the stored value
+	_, _, err = client.DownloadClientAPI.UpdateDownloadClient(ctx, "1").DownloadClientResource(*created).Execute()
+	assert.NoError(t, err)
+
+	fields, _ := server.collections["downloadclient"].items[1]["fields"].([]interface{})
+	stored, _ := fields[1].(object)
+	assert.Equal(t, "secret", stored["value"])
+
+	// Names must be unique
+	_, httpResp, err := client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(*downloadClient).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, httpResp.StatusCode)
+
+	var apiError *sonarr.GenericOpenAPIError
+
+	assert.ErrorAs(t, err, &apiError)
+	assert.Contains(t, string(apiError.Body()), `"propertyName":"Name"`)
+}
+
+func TestServerSystem(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+
+	status, _, err := server.Client().SystemAPI.GetSystemStatus(ctx).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, Version, status.GetVersion())
+
+	host, _, err := server.Client().HostConfigAPI.GetHostConfig(ctx).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), host.GetId())
+
+	config := sonarr.NewConfiguration()
+	config.Servers = sonarr.ServerConfigurations{{URL: server.URL}}
+
+	_, httpResp, err := sonarr.NewAPIClient(config).SystemAPI.GetSystemStatus(ctx).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, httpResp.StatusCode)
+}
+
+func TestServerFixtures(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	definitions, _, err := client.QualityDefinitionAPI.ListQualityDefinition(ctx).Execute()
+	assert.NoError(t, err)
+	assert.Len(t, definitions, 22)
+	assert.Equal(t, "Bluray-2160p", definitions[20].Quality.GetName())
+	assert.Equal(t, int32(2160), definitions[20].Quality.GetResolution())
+
+	profiles, _, err := client.QualityProfileAPI.ListQualityProfile(ctx).Execute()
+	assert.NoError(t, err)
+	assert.Len(t, profiles, 6)
+	assert.Equal(t, "Any", profiles[0].GetName())
+	assert.Equal(t, int32(1), profiles[0].GetCutoff())
+
+	languages, _, err := client.LanguageAPI.ListLanguage(ctx).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "english", languages[1].GetNameLower())
+
+	status, _, err := client.SystemAPI.GetSystemStatus(ctx).Execute()
+	assert.NoError(t, err)
+	assert.True(t, status.GetIsProduction())
+}
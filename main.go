@@ -29,6 +29,15 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
+	// `terraform-provider-sonarr export` generates the configuration of an existing Sonarr.
+	if flag.Arg(0) == "export" {
+		if err := export(context.Background(), flag.Args()[1:]); err != nil {
+			log.Fatal(err.Error())
+		}
+
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/devopsarr/sonarr",
 		Debug:   debug,